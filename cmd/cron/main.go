@@ -338,6 +338,7 @@ func (g *Generator) generate(ctx context.Context, subCategory, level int, catego
 	// 저장
 	sentenceKey := fmt.Sprintf("%d_%d", subCategory, level)
 	savedCount := 0
+	quizPool := g.quizPool(level)
 
 	for _, gen := range generated {
 		sentence := model.Sentence{
@@ -348,6 +349,7 @@ func (g *Generator) generate(ctx context.Context, subCategory, level int, catego
 			Level:       level,
 			SubCategory: subCategory,
 		}
+		sentence.SetSearchText(gen.Words)
 
 		if err := g.db.Create(&sentence).Error; err != nil {
 			log.Printf("Failed to save sentence: %v", err)
@@ -376,10 +378,19 @@ func (g *Generator) generate(ctx context.Context, subCategory, level int, catego
 			if err := g.db.Model(&sentence).Update("audio_url", audioURL).Error; err != nil {
 				log.Printf("Failed to update audio_url for sentence %d: %v", sentence.ID, err)
 			} else {
+				sentence.AudioURL = audioURL
 				log.Printf("Generated TTS for sentence %d: %s", sentence.ID, audioURL)
 			}
 		}
 
+		// Step 3: 뜻 고르기/듣기/받아쓰기/단어 읽기 퀴즈 추가 (음성이 있어야 듣기/받아쓰기 생성)
+		if detail.ID != 0 && detail.CompleteQuiz(&sentence, quizPool) {
+			if err := g.db.Model(&detail).Select("quiz").Updates(&detail).Error; err != nil {
+				log.Printf("Failed to update quiz for sentence %d: %v", sentence.ID, err)
+			}
+		}
+		quizPool = append(quizPool, sentence)
+
 		savedCount++
 	}
 
@@ -387,6 +398,15 @@ func (g *Generator) generate(ctx context.Context, subCategory, level int, catego
 	return nil
 }
 
+// quizPool 뜻 고르기/듣기 오답 보기로 쓸 같은 레벨 문장 (무작위)
+func (g *Generator) quizPool(level int) []model.Sentence {
+	var pool []model.Sentence
+	if err := g.db.Where("level = ?", level).Order("RANDOM()").Limit(50).Find(&pool).Error; err != nil {
+		log.Printf("Failed to load quiz pool for level %d: %v", level, err)
+	}
+	return pool
+}
+
 func (g *Generator) PrintStatus() {
 	log.Println("=== Current Pool Status ===")
 
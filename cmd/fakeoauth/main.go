@@ -0,0 +1,322 @@
+// fakeoauth 로컬 개발/테스트용 가짜 OAuth 서버
+//
+// Google, Kakao, LINE, Apple의 authorize/token/userinfo 엔드포인트를 흉내 냅니다.
+// 로그인 화면 없이 바로 redirect_uri로 돌아가며, ?user=alice 로 로그인할 사용자를 바꿀 수 있습니다.
+// 사용법은 README의 "로컬 fake OAuth 서버" 항목을 참고하세요.
+package main
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"hash/fnv"
+	"html/template"
+	"log"
+	"math/big"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const appleKeyID = "fake-apple-key"
+
+// grant authorize에서 발급한 코드 정보
+type grant struct {
+	provider  string
+	user      string
+	clientID  string
+	challenge string
+}
+
+type server struct {
+	baseURL string
+	signKey *rsa.PrivateKey
+
+	mu     sync.Mutex
+	codes  map[string]grant
+	tokens map[string]grant // access_token / LINE id_token -> grant
+}
+
+var formPostTemplate = template.Must(template.New("form_post").Parse(`<!DOCTYPE html>
+<html><body onload="document.forms[0].submit()">
+<form method="post" action="{{.Action}}">
+<input type="hidden" name="code" value="{{.Code}}">
+<input type="hidden" name="state" value="{{.State}}">
+</form></body></html>`))
+
+func main() {
+	port := os.Getenv("FAKE_OAUTH_PORT")
+	if port == "" {
+		port = "9999"
+	}
+
+	signKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		log.Fatalf("Failed to generate signing key: %v", err)
+	}
+
+	s := newServer("http://localhost:"+port, signKey)
+
+	log.Printf("Fake OAuth server listening on %s", s.baseURL)
+	if err := http.ListenAndServe(":"+port, s.handler()); err != nil {
+		log.Fatalf("Server error: %v", err)
+	}
+}
+
+// newServer fake OAuth 서버 생성 (baseURL은 Apple id_token의 iss에 사용)
+func newServer(baseURL string, signKey *rsa.PrivateKey) *server {
+	return &server{
+		baseURL: baseURL,
+		signKey: signKey,
+		codes:   make(map[string]grant),
+		tokens:  make(map[string]grant),
+	}
+}
+
+// handler 공급자별 엔드포인트 등록
+func (s *server) handler() http.Handler {
+	mux := http.NewServeMux()
+	for _, provider := range []string{"google", "kakao", "line", "apple"} {
+		mux.HandleFunc("/"+provider+"/authorize", s.authorize(provider))
+		mux.HandleFunc("/"+provider+"/token", s.token(provider))
+	}
+	mux.HandleFunc("/google/userinfo", s.googleUserInfo)
+	mux.HandleFunc("/kakao/userinfo", s.kakaoUserInfo)
+	mux.HandleFunc("/line/verify", s.lineVerify)
+	mux.HandleFunc("/apple/keys", s.appleKeys)
+	return mux
+}
+
+// authorize 사용자 동의 없이 바로 코드를 발급해 redirect_uri로 돌려보냄
+func (s *server) authorize(provider string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		redirectURI := q.Get("redirect_uri")
+		if redirectURI == "" {
+			http.Error(w, "redirect_uri is required", http.StatusBadRequest)
+			return
+		}
+
+		user := q.Get("user")
+		if user == "" {
+			user = "fake-user"
+		}
+
+		code := randomString()
+		s.mu.Lock()
+		s.codes[code] = grant{
+			provider:  provider,
+			user:      user,
+			clientID:  q.Get("client_id"),
+			challenge: q.Get("code_challenge"),
+		}
+		s.mu.Unlock()
+
+		if q.Get("response_mode") == "form_post" {
+			w.Header().Set("Content-Type", "text/html; charset=utf-8")
+			formPostTemplate.Execute(w, map[string]string{
+				"Action": redirectURI,
+				"Code":   code,
+				"State":  q.Get("state"),
+			})
+			return
+		}
+
+		target, err := url.Parse(redirectURI)
+		if err != nil {
+			http.Error(w, "invalid redirect_uri", http.StatusBadRequest)
+			return
+		}
+		params := target.Query()
+		params.Set("code", code)
+		params.Set("state", q.Get("state"))
+		target.RawQuery = params.Encode()
+		http.Redirect(w, r, target.String(), http.StatusFound)
+	}
+}
+
+// token 코드를 한 번만 토큰으로 교환 (PKCE code_challenge가 있었다면 verifier 검증)
+func (s *server) token(provider string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			http.Error(w, "invalid form", http.StatusBadRequest)
+			return
+		}
+
+		s.mu.Lock()
+		g, ok := s.codes[r.PostForm.Get("code")]
+		delete(s.codes, r.PostForm.Get("code"))
+		s.mu.Unlock()
+
+		if !ok || g.provider != provider {
+			writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_grant"})
+			return
+		}
+		if g.challenge != "" {
+			sum := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+			if base64.RawURLEncoding.EncodeToString(sum[:]) != g.challenge {
+				writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_grant", "error_description": "pkce mismatch"})
+				return
+			}
+		}
+		if g.clientID == "" {
+			g.clientID = r.PostForm.Get("client_id")
+		}
+
+		accessToken := randomString()
+		resp := map[string]interface{}{
+			"access_token": accessToken,
+			"token_type":   "Bearer",
+			"expires_in":   3600,
+		}
+
+		s.mu.Lock()
+		s.tokens[accessToken] = g
+		s.mu.Unlock()
+
+		switch provider {
+		case "line":
+			idToken := randomString()
+			s.mu.Lock()
+			s.tokens[idToken] = g
+			s.mu.Unlock()
+			resp["id_token"] = idToken
+		case "apple":
+			idToken, err := s.appleIDToken(g)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+			resp["id_token"] = idToken
+		}
+
+		writeJSON(w, http.StatusOK, resp)
+	}
+}
+
+func (s *server) googleUserInfo(w http.ResponseWriter, r *http.Request) {
+	g, ok := s.bearerGrant(r)
+	if !ok {
+		http.Error(w, "invalid token", http.StatusUnauthorized)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"id":             "google-" + g.user,
+		"email":          g.user + "@gmail.example.com",
+		"verified_email": true,
+		"name":           g.user,
+		"picture":        "",
+	})
+}
+
+func (s *server) kakaoUserInfo(w http.ResponseWriter, r *http.Request) {
+	g, ok := s.bearerGrant(r)
+	if !ok {
+		http.Error(w, "invalid token", http.StatusUnauthorized)
+		return
+	}
+
+	h := fnv.New32a()
+	h.Write([]byte(g.user))
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"id": int64(h.Sum32()),
+		"kakao_account": map[string]interface{}{
+			"email":             g.user + "@kakao.example.com",
+			"is_email_valid":    true,
+			"is_email_verified": true,
+			"profile": map[string]interface{}{
+				"nickname":          g.user,
+				"profile_image_url": "",
+			},
+		},
+	})
+}
+
+func (s *server) lineVerify(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "invalid form", http.StatusBadRequest)
+		return
+	}
+
+	s.mu.Lock()
+	g, ok := s.tokens[r.PostForm.Get("id_token")]
+	s.mu.Unlock()
+	if !ok {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_request"})
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"iss":     "https://access.line.me",
+		"sub":     "U" + hex.EncodeToString([]byte(g.user)),
+		"aud":     r.PostForm.Get("client_id"),
+		"name":    g.user,
+		"picture": "",
+		"email":   g.user + "@line.example.com",
+	})
+}
+
+func (s *server) appleKeys(w http.ResponseWriter, _ *http.Request) {
+	pub := s.signKey.PublicKey
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"keys": []map[string]string{{
+			"kty": "RSA",
+			"kid": appleKeyID,
+			"use": "sig",
+			"alg": "RS256",
+			"n":   base64.RawURLEncoding.EncodeToString(pub.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes()),
+		}},
+	})
+}
+
+// appleIDToken APPLE_ISSUER={baseURL}/apple 로 검증되는 id_token 발급
+func (s *server) appleIDToken(g grant) (string, error) {
+	now := time.Now()
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+		"iss":            s.baseURL + "/apple",
+		"aud":            g.clientID,
+		"sub":            "apple." + g.user,
+		"email":          g.user + "@privaterelay.example.com",
+		"email_verified": "true",
+		"iat":            now.Unix(),
+		"exp":            now.Add(10 * time.Minute).Unix(),
+	})
+	token.Header["kid"] = appleKeyID
+	return token.SignedString(s.signKey)
+}
+
+func (s *server) bearerGrant(r *http.Request) (grant, bool) {
+	accessToken, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok {
+		return grant{}, false
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	g, ok := s.tokens[accessToken]
+	return g, ok
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		log.Printf("Failed to write response: %v", err)
+	}
+}
+
+func randomString() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
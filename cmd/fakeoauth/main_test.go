@@ -0,0 +1,190 @@
+package main
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"hash/fnv"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/jptaku/server/internal/pkg"
+)
+
+const testRedirectURL = "http://app.example.com/api/auth/callback"
+
+// newTestServer httptest로 띄운 fake OAuth 서버
+func newTestServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	signKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("GenerateKey() error = %v", err)
+	}
+	s := newServer("", signKey)
+	ts := httptest.NewServer(s.handler())
+	s.baseURL = ts.URL
+	t.Cleanup(ts.Close)
+	return ts
+}
+
+func providerConfig(baseURL, name string) pkg.OAuthProviderConfig {
+	userInfo := map[string]string{"google": "/userinfo", "kakao": "/userinfo", "line": "/verify", "apple": "/keys"}[name]
+	return pkg.OAuthProviderConfig{
+		ClientID:     name + "-client",
+		ClientSecret: name + "-secret",
+		RedirectURL:  testRedirectURL,
+		Endpoints: pkg.OAuthEndpoints{
+			AuthURL:     baseURL + "/" + name + "/authorize",
+			TokenURL:    baseURL + "/" + name + "/token",
+			UserInfoURL: baseURL + "/" + name + userInfo,
+		},
+	}
+}
+
+// testAppleKey client_secret 서명용 임의의 P-256 키 (PEM)
+func testAppleKey(t *testing.T) string {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("GenerateKey() error = %v", err)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatalf("MarshalPKCS8PrivateKey() error = %v", err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+}
+
+var formCodePattern = regexp.MustCompile(`name="code" value="([^"]+)"`)
+
+// authorize 인증 URL을 열어 발급된 코드를 받음 (redirect 또는 form_post)
+func authorize(t *testing.T, provider pkg.OAuthProvider, user, state, verifier string) string {
+	t.Helper()
+
+	client := &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	}}
+	resp, err := client.Get(provider.AuthCodeURL(state, verifier) + "&user=" + url.QueryEscape(user))
+	if err != nil {
+		t.Fatalf("authorize request error = %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusFound {
+		location, err := url.Parse(resp.Header.Get("Location"))
+		if err != nil {
+			t.Fatalf("invalid redirect location: %v", err)
+		}
+		if got := location.Query().Get("state"); got != state {
+			t.Fatalf("redirect state = %q, want %q", got, state)
+		}
+		return location.Query().Get("code")
+	}
+
+	body, _ := io.ReadAll(resp.Body)
+	match := formCodePattern.FindSubmatch(body)
+	if resp.StatusCode != http.StatusOK || match == nil {
+		t.Fatalf("authorize returned status %d without a code: %s", resp.StatusCode, body)
+	}
+	return string(match[1])
+}
+
+func TestProvidersAgainstFakeServer(t *testing.T) {
+	ts := newTestServer(t)
+
+	kakaoID := fnv.New32a()
+	kakaoID.Write([]byte("alice"))
+
+	apple, err := pkg.NewAppleProvider(pkg.AppleProviderConfig{
+		OAuthProviderConfig: providerConfig(ts.URL, "apple"),
+		TeamID:              "TEAMID",
+		KeyID:               "KEYID",
+		PrivateKey:          testAppleKey(t),
+		Issuer:              ts.URL + "/apple",
+	})
+	if err != nil {
+		t.Fatalf("NewAppleProvider() error = %v", err)
+	}
+
+	tests := []struct {
+		provider pkg.OAuthProvider
+		want     pkg.OAuthUserInfo
+	}{
+		{pkg.NewGoogleProvider(providerConfig(ts.URL, "google")), pkg.OAuthUserInfo{
+			Provider: pkg.OAuthProviderGoogle, ID: "google-alice", Email: "alice@gmail.example.com", EmailVerified: true, Name: "alice",
+		}},
+		{pkg.NewKakaoProvider(providerConfig(ts.URL, "kakao")), pkg.OAuthUserInfo{
+			Provider: pkg.OAuthProviderKakao, ID: strconv.FormatInt(int64(kakaoID.Sum32()), 10), Email: "alice@kakao.example.com", EmailVerified: true, Name: "alice",
+		}},
+		{pkg.NewLineProvider(providerConfig(ts.URL, "line")), pkg.OAuthUserInfo{
+			Provider: pkg.OAuthProviderLine, ID: "U" + hex.EncodeToString([]byte("alice")), Email: "alice@line.example.com", EmailVerified: true, Name: "alice",
+		}},
+		{apple, pkg.OAuthUserInfo{
+			Provider: pkg.OAuthProviderApple, ID: "apple.alice", Email: "alice@privaterelay.example.com", EmailVerified: true,
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.provider.Name(), func(t *testing.T) {
+			ctx := context.Background()
+			verifier := "verifier-" + tt.provider.Name() + "-0123456789012345678901234567890123456789"
+
+			code := authorize(t, tt.provider, "alice", "state-"+tt.provider.Name(), verifier)
+			token, err := tt.provider.Exchange(ctx, code, verifier)
+			if err != nil {
+				t.Fatalf("Exchange() error = %v", err)
+			}
+
+			info, err := tt.provider.UserInfo(ctx, token)
+			if err != nil {
+				t.Fatalf("UserInfo() error = %v", err)
+			}
+			if *info != tt.want {
+				t.Errorf("UserInfo() = %+v, want %+v", *info, tt.want)
+			}
+
+			// 코드는 한 번만 교환할 수 있습니다.
+			if _, err := tt.provider.Exchange(ctx, code, verifier); err == nil {
+				t.Error("second Exchange() with the same code: want error")
+			}
+		})
+	}
+}
+
+func TestExchangeRejectsWrongVerifier(t *testing.T) {
+	ts := newTestServer(t)
+	provider := pkg.NewGoogleProvider(providerConfig(ts.URL, "google"))
+
+	code := authorize(t, provider, "bob", "state", "verifier-0123456789012345678901234567890123456789")
+	if _, err := provider.Exchange(context.Background(), code, "other-verifier-0123456789012345678901234567890123"); err == nil {
+		t.Fatal("Exchange() with a mismatched PKCE verifier: want error")
+	}
+}
+
+func TestUserInfoRejectsUnknownToken(t *testing.T) {
+	ts := newTestServer(t)
+
+	for _, path := range []string{"/google/userinfo", "/kakao/userinfo"} {
+		req, _ := http.NewRequest(http.MethodGet, ts.URL+path, nil)
+		req.Header.Set("Authorization", "Bearer unknown")
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("%s request error = %v", path, err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusUnauthorized {
+			t.Errorf("%s status = %d, want %d", path, resp.StatusCode, http.StatusUnauthorized)
+		}
+	}
+}
@@ -0,0 +1,5 @@
+-- 퀴즈 유형 추가 (받아쓰기, 단어 읽기)
+INSERT INTO quiz_types (code, name_kr, description) VALUES
+('dictation', '받아쓰기', '음성을 듣고 문장을 입력하세요'),
+('reading', '단어 읽기', '한자 단어의 읽는 법을 가나로 입력하세요')
+ON CONFLICT (code) DO NOTHING;
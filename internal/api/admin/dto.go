@@ -0,0 +1,14 @@
+package admin
+
+// UpdateRoleRequest 역할 변경 요청
+type UpdateRoleRequest struct {
+	Role string `json:"role" binding:"required"` // user, operator, admin
+}
+
+// QuizStatsQuery 문장별 퀴즈 통계 조회 조건
+type QuizStatsQuery struct {
+	SentenceID       uint `form:"sentence_id"`                                  // 0이면 전체 문장
+	MinFirstAttempts int  `form:"min_first_attempts" binding:"omitempty,min=1"` // 표본이 적은 문장 제외 (기본 5)
+	Page             int  `form:"page" binding:"omitempty,min=1"`
+	PerPage          int  `form:"per_page" binding:"omitempty,min=1,max=100"`
+}
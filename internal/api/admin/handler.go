@@ -0,0 +1,173 @@
+package admin
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jptaku/server/internal/middleware"
+	"github.com/jptaku/server/internal/pkg"
+	adminSvc "github.com/jptaku/server/internal/service/admin"
+)
+
+type Handler struct {
+	adminService adminSvc.Provider
+}
+
+func NewHandler(adminService adminSvc.Provider) *Handler {
+	return &Handler{adminService: adminService}
+}
+
+// RegisterRoutes 관리자 라우트 등록
+// r은 인증과 관리자 역할 확인이 끝난 /api/admin 그룹입니다. 기능별 권한은 라우트마다 확인합니다.
+func (h *Handler) RegisterRoutes(r *gin.RouterGroup) {
+	users := r.Group("/users")
+	{
+		users.GET("", middleware.RequirePermission(pkg.PermissionViewOps), h.FindUser)
+		users.GET("/:id", middleware.RequirePermission(pkg.PermissionViewOps), h.GetUser)
+		users.PUT("/:id/role", middleware.RequirePermission(pkg.PermissionManageUsers), h.UpdateRole)
+	}
+
+	r.GET("/quiz-stats", middleware.RequirePermission(pkg.PermissionManageContent), h.GetQuizStats)
+}
+
+// FindUser godoc
+// @Summary 이메일로 사용자 조회 (관리자)
+// @Tags Admin
+// @Security BearerAuth
+// @Produce json
+// @Param email query string true "이메일"
+// @Success 200 {object} adminSvc.UserDetail
+// @Router /api/admin/users [get]
+func (h *Handler) FindUser(c *gin.Context) {
+	email := c.Query("email")
+	if email == "" {
+		pkg.BadRequestResponse(c, "email이 필요합니다")
+		return
+	}
+
+	detail, err := h.adminService.FindUserByEmail(email)
+	if err != nil {
+		h.userFailure(c, err)
+		return
+	}
+
+	pkg.SuccessResponse(c, detail)
+}
+
+// GetUser godoc
+// @Summary 사용자 조회 (관리자)
+// @Tags Admin
+// @Security BearerAuth
+// @Produce json
+// @Param id path int true "사용자 ID"
+// @Success 200 {object} adminSvc.UserDetail
+// @Router /api/admin/users/{id} [get]
+func (h *Handler) GetUser(c *gin.Context) {
+	userID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		pkg.BadRequestResponse(c, "유효하지 않은 사용자 ID입니다")
+		return
+	}
+
+	detail, err := h.adminService.GetUser(uint(userID))
+	if err != nil {
+		h.userFailure(c, err)
+		return
+	}
+
+	pkg.SuccessResponse(c, detail)
+}
+
+// UpdateRole godoc
+// @Summary 사용자 역할 변경 (관리자)
+// @Description 관리 API 권한에는 바로 반영되고, 대상 사용자의 모든 세션이 폐기되어 다시 로그인해야 합니다. 자기 자신의 역할은 변경할 수 없습니다.
+// @Tags Admin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path int true "사용자 ID"
+// @Param request body UpdateRoleRequest true "역할"
+// @Success 200 {object} adminSvc.UserDetail
+// @Router /api/admin/users/{id}/role [put]
+func (h *Handler) UpdateRole(c *gin.Context) {
+	actorID := middleware.GetUserID(c)
+
+	userID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		pkg.BadRequestResponse(c, "유효하지 않은 사용자 ID입니다")
+		return
+	}
+
+	var req UpdateRoleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		pkg.BadRequestResponse(c, err.Error())
+		return
+	}
+
+	detail, err := h.adminService.UpdateRole(c.Request.Context(), actorID, uint(userID), &adminSvc.UpdateRoleInput{Role: pkg.Role(req.Role)})
+	if err != nil {
+		switch {
+		case errors.Is(err, pkg.ErrBadRequest):
+			pkg.BadRequestResponse(c, "알 수 없는 역할입니다")
+		case errors.Is(err, pkg.ErrForbidden):
+			pkg.ForbiddenResponse(c, "자기 자신의 역할은 변경할 수 없습니다")
+		default:
+			h.userFailure(c, err)
+		}
+		return
+	}
+
+	pkg.SuccessResponse(c, detail)
+}
+
+// GetQuizStats godoc
+// @Summary 문장별 퀴즈 난이도 통계 (관리자)
+// @Description 첫 시도 정답률이 낮은 문장부터 반환합니다. 가장 많이 고른 빈칸 채우기 오답도 함께 보여줍니다.
+// @Tags Admin
+// @Security BearerAuth
+// @Produce json
+// @Param sentence_id query int false "문장 ID (없으면 전체)"
+// @Param min_first_attempts query int false "최소 첫 시도 수" default(5)
+// @Param page query int false "페이지 번호" default(1)
+// @Param per_page query int false "페이지당 개수" default(20)
+// @Success 200 {object} pkg.PaginatedResponse
+// @Router /api/admin/quiz-stats [get]
+func (h *Handler) GetQuizStats(c *gin.Context) {
+	var query QuizStatsQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		pkg.BadRequestResponse(c, err.Error())
+		return
+	}
+	if query.MinFirstAttempts == 0 {
+		query.MinFirstAttempts = 5
+	}
+	if query.Page == 0 {
+		query.Page = 1
+	}
+	if query.PerPage == 0 {
+		query.PerPage = 20
+	}
+
+	stats, total, err := h.adminService.GetQuizStats(&adminSvc.QuizStatsInput{
+		SentenceID:       query.SentenceID,
+		MinFirstAttempts: query.MinFirstAttempts,
+		Page:             query.Page,
+		PerPage:          query.PerPage,
+	})
+	if err != nil {
+		pkg.InternalServerErrorResponse(c, "퀴즈 통계 조회에 실패했습니다")
+		return
+	}
+
+	pkg.PaginatedSuccessResponse(c, stats, query.Page, query.PerPage, total)
+}
+
+// userFailure 사용자 조회 실패 응답
+func (h *Handler) userFailure(c *gin.Context, err error) {
+	if errors.Is(err, pkg.ErrNotFound) {
+		pkg.NotFoundResponse(c, "사용자를 찾을 수 없습니다")
+		return
+	}
+	pkg.InternalServerErrorResponse(c, "사용자 조회에 실패했습니다")
+}
@@ -4,12 +4,63 @@ type RefreshRequest struct {
 	RefreshToken string `json:"refresh_token" binding:"required"`
 }
 
+type LogoutRequest struct {
+	RefreshToken string `json:"refresh_token" binding:"required"`
+}
+
+// ExchangeRequest 로그인 코드 교환 요청
+type ExchangeRequest struct {
+	Code         string `json:"code" binding:"required"`
+	CodeVerifier string `json:"code_verifier" binding:"required"` // 로그인 시작 시 보낸 code_challenge의 원문
+}
+
+// SignupRequest 이메일 회원가입 요청
+type SignupRequest struct {
+	Email    string `json:"email" binding:"required"`
+	Password string `json:"password" binding:"required"` // 6~72바이트
+	Name     string `json:"name"`
+}
+
+// LoginRequest 이메일 로그인 요청
+type LoginRequest struct {
+	Email    string `json:"email" binding:"required"`
+	Password string `json:"password" binding:"required"`
+}
+
+// EmailRequest 인증 메일 재발송/비밀번호 재설정 메일 요청
+type EmailRequest struct {
+	Email string `json:"email" binding:"required"`
+}
+
+// VerifyEmailRequest 이메일 인증 요청
+type VerifyEmailRequest struct {
+	Token string `json:"token" binding:"required"`
+}
+
+// ResetPasswordRequest 비밀번호 재설정 요청
+type ResetPasswordRequest struct {
+	Token    string `json:"token" binding:"required"`
+	Password string `json:"password" binding:"required"`
+}
+
 type TokenResponse struct {
 	AccessToken  string `json:"access_token"`
 	RefreshToken string `json:"refresh_token"`
 	User         any    `json:"user"`
 }
 
-type GoogleAuthURLResponse struct {
+// OAuthURLResponse OAuth 로그인 URL 응답
+type OAuthURLResponse struct {
 	URL string `json:"url"`
 }
+
+// LinkResponse 계정 연결 결과
+type LinkResponse struct {
+	Identity    any    `json:"identity,omitempty"`
+	MergeTicket string `json:"merge_ticket,omitempty"` // 이미 다른 계정에 연결된 공급자 계정이면 병합 확인용 티켓
+}
+
+// MergeRequest 계정 병합 요청
+type MergeRequest struct {
+	Ticket string `json:"ticket" binding:"required"`
+}
@@ -1,9 +1,15 @@
 package auth
 
 import (
-	"fmt"
+	"errors"
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
 
 	"github.com/gin-gonic/gin"
+	"github.com/jptaku/server/internal/middleware"
 	"github.com/jptaku/server/internal/pkg"
 	authSvc "github.com/jptaku/server/internal/service/auth"
 )
@@ -16,15 +22,39 @@ func NewHandler(authService authSvc.Provider) *Handler {
 	return &Handler{authService: authService}
 }
 
-func (h *Handler) RegisterRoutes(r *gin.RouterGroup) {
+func (h *Handler) RegisterRoutes(r *gin.RouterGroup, authMiddleware gin.HandlerFunc) {
 	auth := r.Group("/auth")
 	{
 		auth.POST("/refresh", h.Refresh)
 		auth.POST("/logout", h.Logout)
+		auth.POST("/exchange", h.Exchange)
+		auth.POST("/guest", h.Guest)
 
-		// Google OAuth
-		auth.GET("/google", h.GoogleAuth)
-		auth.GET("/google/callback", h.GoogleCallback)
+		// 이메일/비밀번호 계정
+		auth.POST("/signup", h.Signup)
+		auth.POST("/login", h.Login)
+		auth.GET("/email/verify", h.VerifyEmail) // 메일 링크
+		auth.POST("/email/verify", h.VerifyEmail)
+		auth.POST("/email/resend", h.ResendVerification)
+		auth.POST("/password/forgot", h.ForgotPassword)
+		auth.POST("/password/reset", h.ResetPassword)
+
+		// 로그인 수단 연결/해제/병합 (로그인 필요)
+		linked := auth.Group("", authMiddleware)
+		linked.GET("/link/:provider", h.LinkStart)
+		linked.GET("/identities", h.ListIdentities)
+		linked.DELETE("/identities/:provider", h.Unlink)
+		linked.POST("/merge", h.Merge)
+
+		// 기기 세션 (로그인 필요)
+		linked.GET("/sessions", h.ListSessions)
+		linked.DELETE("/sessions", h.RevokeAllSessions)
+		linked.DELETE("/sessions/:id", h.RevokeSession)
+
+		// OAuth (google, kakao, line, apple)
+		auth.GET("/:provider", h.OAuthStart)
+		auth.GET("/:provider/callback", h.OAuthCallback)
+		auth.POST("/:provider/callback", h.OAuthCallback) // Apple form_post
 	}
 }
 
@@ -44,7 +74,7 @@ func (h *Handler) Refresh(c *gin.Context) {
 		return
 	}
 
-	result, err := h.authService.RefreshToken(req.RefreshToken)
+	result, err := h.authService.RefreshToken(c.Request.Context(), req.RefreshToken, deviceInfo(c))
 	if err != nil {
 		pkg.UnauthorizedResponse(c, "유효하지 않은 토큰입니다")
 		return
@@ -55,76 +85,629 @@ func (h *Handler) Refresh(c *gin.Context) {
 
 // Logout godoc
 // @Summary 로그아웃
-// @Description 로그아웃 (클라이언트에서 토큰 삭제)
+// @Description Refresh Token과 같은 로그인에서 발급된 토큰을 모두 폐기
 // @Tags Auth
+// @Accept json
+// @Produce json
+// @Param request body LogoutRequest true "Refresh Token"
 // @Success 200 {object} pkg.Response
 // @Router /api/auth/logout [post]
 func (h *Handler) Logout(c *gin.Context) {
+	var req LogoutRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		pkg.BadRequestResponse(c, err.Error())
+		return
+	}
+
+	if err := h.authService.Logout(c.Request.Context(), req.RefreshToken); err != nil {
+		if errors.Is(err, pkg.ErrInvalidToken) {
+			pkg.UnauthorizedResponse(c, "유효하지 않은 토큰입니다")
+			return
+		}
+		pkg.InternalServerErrorResponse(c, "로그아웃 처리에 실패했습니다")
+		return
+	}
+
 	pkg.SuccessMessageResponse(c, "로그아웃 되었습니다")
 }
 
-// GoogleAuth godoc
-// @Summary Google OAuth 로그인 URL 생성
-// @Description Google 로그인을 위한 인증 URL을 반환합니다
+// Exchange godoc
+// @Summary 로그인 코드 교환
+// @Description OAuth 콜백에서 받은 1회용 로그인 코드를 토큰으로 교환합니다. 로그인 시작 시 보낸 code_challenge의 code_verifier가 필요합니다.
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param request body ExchangeRequest true "로그인 코드 + code_verifier"
+// @Success 200 {object} TokenResponse
+// @Router /api/auth/exchange [post]
+func (h *Handler) Exchange(c *gin.Context) {
+	var req ExchangeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		pkg.BadRequestResponse(c, err.Error())
+		return
+	}
+
+	result, err := h.authService.ExchangeLoginCode(c.Request.Context(), req.Code, req.CodeVerifier, deviceInfo(c))
+	if err != nil {
+		pkg.UnauthorizedResponse(c, "유효하지 않거나 만료된 로그인 코드입니다")
+		return
+	}
+
+	pkg.SuccessResponse(c, result)
+}
+
+// Guest godoc
+// @Summary 게스트로 시작
+// @Description 로그인 없이 게스트 계정을 만들고 토큰을 발급합니다. 나중에 /api/auth/link/{provider}로 공급자를 연결하면 학습 기록을 유지한 채 정식 계정으로 전환됩니다.
+// @Tags Auth
+// @Produce json
+// @Success 201 {object} TokenResponse
+// @Router /api/auth/guest [post]
+func (h *Handler) Guest(c *gin.Context) {
+	result, err := h.authService.CreateGuest(c.Request.Context(), deviceInfo(c))
+	if err != nil {
+		pkg.InternalServerErrorResponse(c, "게스트 계정 생성에 실패했습니다")
+		return
+	}
+
+	pkg.CreatedResponse(c, result)
+}
+
+// Signup godoc
+// @Summary 이메일 회원가입
+// @Description 이메일/비밀번호로 가입하고 바로 로그인합니다. 인증 메일이 발송됩니다.
 // @Tags Auth
+// @Accept json
 // @Produce json
-// @Success 200 {object} GoogleAuthURLResponse
-// @Router /api/auth/google [get]
-func (h *Handler) GoogleAuth(c *gin.Context) {
-	state := c.Query("state")
-	if state == "" {
-		state = "default"
+// @Param request body SignupRequest true "가입 정보"
+// @Success 201 {object} TokenResponse
+// @Router /api/auth/signup [post]
+func (h *Handler) Signup(c *gin.Context) {
+	var req SignupRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		pkg.BadRequestResponse(c, err.Error())
+		return
 	}
 
-	url := h.authService.GetGoogleAuthURL(state)
-	if url == "" {
-		pkg.InternalServerErrorResponse(c, "Google OAuth가 설정되지 않았습니다")
+	result, err := h.authService.Signup(c.Request.Context(), &authSvc.SignupInput{
+		Email:    req.Email,
+		Password: req.Password,
+		Name:     req.Name,
+	}, deviceInfo(c))
+	if err != nil {
+		switch {
+		case errors.Is(err, pkg.ErrBadRequest):
+			pkg.BadRequestResponse(c, "이메일 형식 또는 비밀번호(6~72자)가 올바르지 않습니다")
+		case errors.Is(err, pkg.ErrDuplicateEmail):
+			pkg.ErrorResponse(c, http.StatusConflict, "이미 가입된 이메일입니다")
+		default:
+			pkg.InternalServerErrorResponse(c, "회원가입에 실패했습니다")
+		}
 		return
 	}
 
-	pkg.SuccessResponse(c, GoogleAuthURLResponse{URL: url})
+	pkg.CreatedResponse(c, result)
 }
 
-// GoogleCallback godoc
-// @Summary Google OAuth 콜백
-// @Description Google 로그인 후 콜백을 처리합니다. 모바일 앱인 경우 딥링크로 리다이렉트합니다.
+// Login godoc
+// @Summary 이메일 로그인
 // @Tags Auth
+// @Accept json
 // @Produce json
-// @Param code query string true "Authorization code from Google"
-// @Param state query string false "State parameter (mobile: 모바일 앱으로 리다이렉트)"
+// @Param request body LoginRequest true "이메일/비밀번호"
 // @Success 200 {object} TokenResponse
-// @Router /api/auth/google/callback [get]
-func (h *Handler) GoogleCallback(c *gin.Context) {
-	code := c.Query("code")
-	if code == "" {
-		pkg.BadRequestResponse(c, "인증 코드가 필요합니다")
+// @Router /api/auth/login [post]
+func (h *Handler) Login(c *gin.Context) {
+	var req LoginRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		pkg.BadRequestResponse(c, err.Error())
+		return
+	}
+
+	result, err := h.authService.Login(c.Request.Context(), req.Email, req.Password, deviceInfo(c))
+	if err != nil {
+		if errors.Is(err, pkg.ErrInvalidCredentials) {
+			pkg.UnauthorizedResponse(c, "이메일 또는 비밀번호가 올바르지 않습니다")
+			return
+		}
+		pkg.InternalServerErrorResponse(c, "로그인에 실패했습니다")
+		return
+	}
+
+	pkg.SuccessResponse(c, result)
+}
+
+// VerifyEmail godoc
+// @Summary 이메일 인증
+// @Description 인증 메일의 토큰으로 이메일을 인증합니다. 메일 링크(GET ?token=) 또는 JSON 본문(POST)으로 호출합니다.
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param token query string false "인증 토큰 (GET)"
+// @Param request body VerifyEmailRequest false "인증 토큰 (POST)"
+// @Success 200 {object} pkg.Response
+// @Router /api/auth/email/verify [post]
+func (h *Handler) VerifyEmail(c *gin.Context) {
+	token := c.Query("token")
+	if c.Request.Method == http.MethodPost {
+		var req VerifyEmailRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			pkg.BadRequestResponse(c, err.Error())
+			return
+		}
+		token = req.Token
+	}
+	if token == "" {
+		pkg.BadRequestResponse(c, "token이 필요합니다")
+		return
+	}
+
+	if err := h.authService.VerifyEmail(c.Request.Context(), token); err != nil {
+		if errors.Is(err, pkg.ErrInvalidEmailToken) {
+			pkg.BadRequestResponse(c, "유효하지 않거나 만료된 인증 링크입니다")
+			return
+		}
+		pkg.InternalServerErrorResponse(c, "이메일 인증에 실패했습니다")
+		return
+	}
+
+	pkg.SuccessMessageResponse(c, "이메일 인증이 완료되었습니다")
+}
+
+// ResendVerification godoc
+// @Summary 인증 메일 재발송
+// @Description 계정 존재 여부와 관계없이 같은 응답을 반환합니다.
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param request body EmailRequest true "이메일"
+// @Success 200 {object} pkg.Response
+// @Router /api/auth/email/resend [post]
+func (h *Handler) ResendVerification(c *gin.Context) {
+	var req EmailRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		pkg.BadRequestResponse(c, err.Error())
+		return
+	}
+
+	if err := h.authService.ResendVerification(c.Request.Context(), req.Email); err != nil {
+		pkg.InternalServerErrorResponse(c, "인증 메일 발송에 실패했습니다")
+		return
+	}
+
+	pkg.SuccessMessageResponse(c, "인증이 필요한 계정이면 인증 메일이 발송됩니다")
+}
+
+// ForgotPassword godoc
+// @Summary 비밀번호 재설정 메일 요청
+// @Description 계정 존재 여부와 관계없이 같은 응답을 반환합니다. OAuth로 가입한 계정도 비밀번호를 설정할 수 있습니다.
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param request body EmailRequest true "이메일"
+// @Success 200 {object} pkg.Response
+// @Router /api/auth/password/forgot [post]
+func (h *Handler) ForgotPassword(c *gin.Context) {
+	var req EmailRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		pkg.BadRequestResponse(c, err.Error())
+		return
+	}
+
+	if err := h.authService.RequestPasswordReset(c.Request.Context(), req.Email); err != nil {
+		pkg.InternalServerErrorResponse(c, "비밀번호 재설정 메일 발송에 실패했습니다")
 		return
 	}
 
-	state := c.Query("state")
+	pkg.SuccessMessageResponse(c, "가입된 이메일이면 비밀번호 재설정 메일이 발송됩니다")
+}
 
-	result, err := h.authService.GoogleCallback(c.Request.Context(), code)
+// ResetPassword godoc
+// @Summary 비밀번호 재설정
+// @Description 재설정 메일의 토큰으로 비밀번호를 변경합니다. 모든 기기에서 로그아웃됩니다.
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param request body ResetPasswordRequest true "토큰 + 새 비밀번호"
+// @Success 200 {object} pkg.Response
+// @Router /api/auth/password/reset [post]
+func (h *Handler) ResetPassword(c *gin.Context) {
+	var req ResetPasswordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		pkg.BadRequestResponse(c, err.Error())
+		return
+	}
+
+	if err := h.authService.ResetPassword(c.Request.Context(), req.Token, req.Password); err != nil {
+		switch {
+		case errors.Is(err, pkg.ErrBadRequest):
+			pkg.BadRequestResponse(c, "비밀번호는 6~72자여야 합니다")
+		case errors.Is(err, pkg.ErrInvalidEmailToken):
+			pkg.BadRequestResponse(c, "유효하지 않거나 만료된 재설정 링크입니다")
+		default:
+			pkg.InternalServerErrorResponse(c, "비밀번호 재설정에 실패했습니다")
+		}
+		return
+	}
+
+	pkg.SuccessMessageResponse(c, "비밀번호가 변경되었습니다. 다시 로그인해 주세요")
+}
+
+// LinkStart godoc
+// @Summary 로그인 수단 연결 URL 생성
+// @Description 현재 계정에 다른 공급자 로그인을 연결하기 위한 인증 URL을 반환합니다. 콜백은 /api/auth/{provider}/callback 으로 돌아옵니다.
+// @Tags Auth
+// @Security BearerAuth
+// @Produce json
+// @Param provider path string true "OAuth 공급자 (google, kakao, line, apple)"
+// @Param client query string false "클라이언트 종류 (web, mobile)" default(web)
+// @Param redirect_uri query string false "연결 완료 후 이동할 URI (허용 목록에 있어야 함)"
+// @Param code_challenge query string false "PKCE code_challenge (redirect 플로우 필수)"
+// @Param code_challenge_method query string false "S256"
+// @Success 200 {object} OAuthURLResponse
+// @Router /api/auth/link/{provider} [get]
+func (h *Handler) LinkStart(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		pkg.UnauthorizedResponse(c, "")
+		return
+	}
+
+	input := &authSvc.OAuthStartInput{
+		ClientType:          c.Query("client"),
+		RedirectURI:         c.Query("redirect_uri"),
+		CodeChallenge:       c.Query("code_challenge"),
+		CodeChallengeMethod: c.Query("code_challenge_method"),
+	}
+
+	authURL, err := h.authService.GetOAuthLinkURL(c.Request.Context(), userID, c.Param("provider"), input)
 	if err != nil {
-		fmt.Println("Google callback error:", err)
-		// 모바일 앱인 경우 에러도 딥링크로 전달
-		if state == "mobile" {
-			c.Redirect(302, "jptaku://auth/callback?error=login_failed")
+		h.oauthStartFailure(c, err)
+		return
+	}
+
+	pkg.SuccessResponse(c, OAuthURLResponse{URL: authURL})
+}
+
+// ListIdentities godoc
+// @Summary 연결된 로그인 수단 목록
+// @Tags Auth
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {array} model.UserIdentity
+// @Router /api/auth/identities [get]
+func (h *Handler) ListIdentities(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		pkg.UnauthorizedResponse(c, "")
+		return
+	}
+
+	identities, err := h.authService.ListIdentities(c.Request.Context(), userID)
+	if err != nil {
+		pkg.InternalServerErrorResponse(c, "로그인 수단 조회에 실패했습니다")
+		return
+	}
+
+	pkg.SuccessResponse(c, identities)
+}
+
+// Unlink godoc
+// @Summary 로그인 수단 연결 해제
+// @Description 마지막 남은 로그인 수단은 해제할 수 없습니다.
+// @Tags Auth
+// @Security BearerAuth
+// @Produce json
+// @Param provider path string true "OAuth 공급자"
+// @Success 200 {object} pkg.Response
+// @Router /api/auth/identities/{provider} [delete]
+func (h *Handler) Unlink(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		pkg.UnauthorizedResponse(c, "")
+		return
+	}
+
+	if err := h.authService.UnlinkIdentity(c.Request.Context(), userID, c.Param("provider")); err != nil {
+		switch {
+		case errors.Is(err, pkg.ErrNotFound):
+			pkg.NotFoundResponse(c, "연결된 로그인 수단이 아닙니다")
+		case errors.Is(err, pkg.ErrLastIdentity):
+			pkg.BadRequestResponse(c, "마지막 로그인 수단은 해제할 수 없습니다")
+		default:
+			pkg.InternalServerErrorResponse(c, "로그인 수단 해제에 실패했습니다")
+		}
+		return
+	}
+
+	pkg.SuccessMessageResponse(c, "로그인 수단 연결이 해제되었습니다")
+}
+
+// Merge godoc
+// @Summary 계정 병합
+// @Description 연결하려던 공급자 계정이 이미 다른 계정에 연결되어 있을 때 받은 merge_ticket으로 해당 계정을 현재 계정에 합칩니다. 합쳐진 계정은 삭제됩니다.
+// @Tags Auth
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param request body MergeRequest true "병합 티켓"
+// @Success 200 {array} model.UserIdentity
+// @Router /api/auth/merge [post]
+func (h *Handler) Merge(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		pkg.UnauthorizedResponse(c, "")
+		return
+	}
+
+	var req MergeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		pkg.BadRequestResponse(c, err.Error())
+		return
+	}
+
+	identities, err := h.authService.MergeAccounts(c.Request.Context(), userID, req.Ticket)
+	if err != nil {
+		switch {
+		case errors.Is(err, pkg.ErrInvalidMergeTicket):
+			pkg.BadRequestResponse(c, "유효하지 않거나 만료된 병합 티켓입니다")
+		case errors.Is(err, pkg.ErrForbidden):
+			pkg.ForbiddenResponse(c, "다른 사용자의 병합 티켓입니다")
+		case errors.Is(err, pkg.ErrMergeConflict):
+			pkg.ErrorResponse(c, http.StatusConflict, "두 계정에 같은 공급자의 로그인 수단이 있습니다. 한쪽을 해제한 뒤 다시 시도하세요")
+		default:
+			pkg.InternalServerErrorResponse(c, "계정 병합에 실패했습니다")
+		}
+		return
+	}
+
+	pkg.SuccessResponse(c, identities)
+}
+
+// ListSessions godoc
+// @Summary 로그인된 기기 목록
+// @Description 현재 로그인되어 있는 기기 세션을 최근 사용 순으로 반환합니다. 요청한 기기는 current=true입니다.
+// @Tags Auth
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {array} authSvc.SessionInfo
+// @Router /api/auth/sessions [get]
+func (h *Handler) ListSessions(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		pkg.UnauthorizedResponse(c, "")
+		return
+	}
+
+	sessions, err := h.authService.ListSessions(c.Request.Context(), userID, middleware.GetFamilyID(c))
+	if err != nil {
+		pkg.InternalServerErrorResponse(c, "기기 목록 조회에 실패했습니다")
+		return
+	}
+
+	pkg.SuccessResponse(c, sessions)
+}
+
+// RevokeSession godoc
+// @Summary 기기 로그아웃
+// @Description 선택한 기기의 세션을 폐기합니다. 해당 기기는 더 이상 토큰을 갱신할 수 없습니다.
+// @Tags Auth
+// @Security BearerAuth
+// @Produce json
+// @Param id path int true "세션 ID"
+// @Success 200 {object} pkg.Response
+// @Router /api/auth/sessions/{id} [delete]
+func (h *Handler) RevokeSession(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		pkg.UnauthorizedResponse(c, "")
+		return
+	}
+
+	sessionID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		pkg.BadRequestResponse(c, "유효하지 않은 세션 ID입니다")
+		return
+	}
+
+	if err := h.authService.RevokeSession(c.Request.Context(), userID, uint(sessionID)); err != nil {
+		if errors.Is(err, pkg.ErrNotFound) {
+			pkg.NotFoundResponse(c, "세션을 찾을 수 없습니다")
 			return
 		}
-		pkg.UnauthorizedResponse(c, "Google 로그인에 실패했습니다")
+		pkg.InternalServerErrorResponse(c, "기기 로그아웃에 실패했습니다")
 		return
 	}
 
-	// 모바일 앱인 경우 딥링크로 리다이렉트
-	if state == "mobile" {
-		redirectURL := fmt.Sprintf("jptaku://auth/callback?access_token=%s&refresh_token=%s",
-			result.AccessToken,
-			result.RefreshToken,
-		)
-		c.Redirect(302, redirectURL)
+	pkg.SuccessMessageResponse(c, "기기에서 로그아웃 되었습니다")
+}
+
+// RevokeAllSessions godoc
+// @Summary 모든 기기에서 로그아웃
+// @Description 현재 기기를 포함한 모든 기기 세션을 폐기합니다.
+// @Tags Auth
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {object} pkg.Response
+// @Router /api/auth/sessions [delete]
+func (h *Handler) RevokeAllSessions(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		pkg.UnauthorizedResponse(c, "")
+		return
+	}
+
+	if err := h.authService.RevokeAllSessions(c.Request.Context(), userID); err != nil {
+		pkg.InternalServerErrorResponse(c, "로그아웃 처리에 실패했습니다")
+		return
+	}
+
+	pkg.SuccessMessageResponse(c, "모든 기기에서 로그아웃 되었습니다")
+}
+
+// OAuthStart godoc
+// @Summary OAuth 로그인 URL 생성
+// @Description 공급자(google, kakao, line, apple) 로그인을 위한 인증 URL을 반환합니다. state/PKCE는 서버에서 생성합니다.
+// @Tags Auth
+// @Produce json
+// @Param provider path string true "OAuth 공급자 (google, kakao, line, apple)"
+// @Param client query string false "클라이언트 종류 (web, mobile)" default(web)
+// @Param redirect_uri query string false "로그인 완료 후 이동할 URI (허용 목록에 있어야 함)"
+// @Param code_challenge query string false "PKCE code_challenge (redirect 플로우 필수)"
+// @Param code_challenge_method query string false "S256"
+// @Success 200 {object} OAuthURLResponse
+// @Router /api/auth/{provider} [get]
+func (h *Handler) OAuthStart(c *gin.Context) {
+	clientType := c.Query("client")
+	// 구버전 앱 호환: state=mobile
+	if clientType == "" && c.Query("state") == pkg.OAuthClientMobile {
+		clientType = pkg.OAuthClientMobile
+	}
+
+	input := &authSvc.OAuthStartInput{
+		ClientType:          clientType,
+		RedirectURI:         c.Query("redirect_uri"),
+		CodeChallenge:       c.Query("code_challenge"),
+		CodeChallengeMethod: c.Query("code_challenge_method"),
+	}
+
+	authURL, err := h.authService.GetOAuthAuthURL(c.Request.Context(), c.Param("provider"), input)
+	if err != nil {
+		h.oauthStartFailure(c, err)
+		return
+	}
+
+	pkg.SuccessResponse(c, OAuthURLResponse{URL: authURL})
+}
+
+// oauthStartFailure 인증 URL 생성 실패 응답
+func (h *Handler) oauthStartFailure(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, pkg.ErrUnknownOAuthProvider):
+		pkg.NotFoundResponse(c, "지원하지 않거나 설정되지 않은 로그인 방식입니다")
+	case errors.Is(err, pkg.ErrInvalidOAuthClient), errors.Is(err, pkg.ErrRedirectNotAllowed):
+		pkg.BadRequestResponse(c, "허용되지 않은 클라이언트 또는 redirect_uri입니다")
+	case errors.Is(err, pkg.ErrPKCERequired):
+		pkg.BadRequestResponse(c, "code_challenge(S256)가 필요합니다")
+	default:
+		pkg.InternalServerErrorResponse(c, "로그인 URL 생성에 실패했습니다")
+	}
+}
+
+// OAuthCallback godoc
+// @Summary OAuth 콜백
+// @Description 공급자 로그인 후 콜백을 처리합니다. state에 redirect 대상이 있으면 1회용 로그인 코드와 함께 리다이렉트합니다. Apple은 form_post(POST)로 호출됩니다.
+// @Description 계정 연결(/api/auth/link/{provider})로 시작한 경우 연결 결과(LinkResponse)를 반환하거나 linked/merge_ticket 쿼리로 리다이렉트합니다.
+// @Tags Auth
+// @Produce json
+// @Param provider path string true "OAuth 공급자 (google, kakao, line, apple)"
+// @Param code query string true "공급자가 발급한 authorization code"
+// @Param state query string true "서버가 발급한 state"
+// @Success 200 {object} TokenResponse
+// @Router /api/auth/{provider}/callback [get]
+func (h *Handler) OAuthCallback(c *gin.Context) {
+	provider := c.Param("provider")
+
+	state, err := h.authService.ParseOAuthState(callbackParam(c, "state"))
+	if err != nil || state.Provider != provider {
+		pkg.BadRequestResponse(c, "유효하지 않은 state입니다")
+		return
+	}
+
+	code := callbackParam(c, "code")
+	if code == "" {
+		h.oauthFailure(c, state, http.StatusBadRequest, "인증 코드가 필요합니다")
+		return
+	}
+
+	result, err := h.authService.OAuthCallback(c.Request.Context(), provider, code, state, deviceInfo(c))
+	if err != nil {
+		log.Printf("OAuth callback error (%s): %v", provider, err)
+		if errors.Is(err, pkg.ErrDuplicateEmail) {
+			h.oauthFailure(c, state, http.StatusConflict, "이미 다른 로그인 방식으로 가입된 이메일입니다. 기존 방식으로 로그인한 뒤 연결하세요")
+			return
+		}
+		if errors.Is(err, pkg.ErrIdentityAlreadyLinked) {
+			h.oauthFailure(c, state, http.StatusConflict, "이미 같은 공급자의 다른 계정이 연결되어 있습니다")
+			return
+		}
+		h.oauthFailure(c, state, http.StatusUnauthorized, "로그인에 실패했습니다")
+		return
+	}
+
+	if state.LinkUserID != 0 {
+		h.linkResult(c, state, result)
+		return
+	}
+
+	// redirect 대상이 있으면 (모바일 딥링크 등) 로그인 코드만 전달
+	// 앱은 POST /api/auth/exchange 로 토큰을 받습니다.
+	if state.Redirect != "" {
+		c.Redirect(http.StatusFound, appendQuery(state.Redirect, url.Values{
+			"code": {result.LoginCode},
+		}))
 		return
 	}
 
 	// 웹인 경우 JSON 응답
-	pkg.SuccessResponse(c, result)
+	pkg.SuccessResponse(c, result.Token)
+}
+
+// deviceInfo 요청한 기기 정보 (X-Client-Platform 헤더가 없으면 User-Agent로 추정)
+func deviceInfo(c *gin.Context) *authSvc.DeviceInfo {
+	return &authSvc.DeviceInfo{
+		UserAgent: c.Request.UserAgent(),
+		Platform:  c.GetHeader("X-Client-Platform"),
+		IP:        c.ClientIP(),
+	}
+}
+
+// callbackParam 쿼리 또는 form_post 본문에서 콜백 파라미터 조회
+func callbackParam(c *gin.Context, key string) string {
+	if value := c.Query(key); value != "" {
+		return value
+	}
+	return c.PostForm(key)
+}
+
+// linkResult 계정 연결 콜백 응답 (redirect 대상이 있으면 쿼리로 전달)
+func (h *Handler) linkResult(c *gin.Context, state *pkg.OAuthState, result *authSvc.OAuthCallbackResult) {
+	if state.Redirect != "" {
+		params := url.Values{}
+		if result.MergeTicket != "" {
+			params.Set("merge_ticket", result.MergeTicket)
+		} else {
+			params.Set("linked", state.Provider)
+		}
+		c.Redirect(http.StatusFound, appendQuery(state.Redirect, params))
+		return
+	}
+
+	resp := LinkResponse{MergeTicket: result.MergeTicket}
+	if result.Identity != nil {
+		resp.Identity = result.Identity
+	}
+	pkg.SuccessResponse(c, resp)
+}
+
+// oauthFailure redirect 대상이 있으면 에러를 쿼리로 전달, 없으면 JSON 에러 응답
+func (h *Handler) oauthFailure(c *gin.Context, state *pkg.OAuthState, status int, message string) {
+	if state.Redirect != "" {
+		code := "login_failed"
+		if state.LinkUserID != 0 {
+			code = "link_failed"
+		}
+		c.Redirect(http.StatusFound, appendQuery(state.Redirect, url.Values{"error": {code}}))
+		return
+	}
+	pkg.ErrorResponse(c, status, message)
+}
+
+// appendQuery URI에 쿼리 파라미터 추가
+func appendQuery(uri string, params url.Values) string {
+	sep := "?"
+	if strings.Contains(uri, "?") {
+		sep = "&"
+	}
+	return uri + sep + params.Encode()
 }
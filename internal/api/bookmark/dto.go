@@ -0,0 +1,34 @@
+package bookmark
+
+// ListQuery 즐겨찾기 목록 조회 쿼리
+type ListQuery struct {
+	Page        int   `form:"page" binding:"omitempty,min=1"`
+	PerPage     int   `form:"per_page" binding:"omitempty,min=1,max=50"`
+	FolderID    *uint `form:"folder_id"` // 0이면 폴더 없는 즐겨찾기만
+	SubCategory *int  `form:"sub_category"`
+	Level       *int  `form:"level" binding:"omitempty,min=0,max=3"`
+}
+
+// AddBookmarkRequest 즐겨찾기 추가 요청
+type AddBookmarkRequest struct {
+	SentenceID uint   `json:"sentence_id" binding:"required"`
+	FolderID   uint   `json:"folder_id"` // 생략하거나 0이면 폴더 없음
+	Note       string `json:"note" binding:"max=500"`
+}
+
+// UpdateBookmarkRequest 즐겨찾기 수정 요청 (보낸 필드만 변경)
+type UpdateBookmarkRequest struct {
+	FolderID *uint   `json:"folder_id"` // 0이면 폴더에서 꺼냄
+	Note     *string `json:"note" binding:"omitempty,max=500"`
+}
+
+// ReorderRequest 폴더 안 즐겨찾기 순서 변경 요청
+type ReorderRequest struct {
+	FolderID    uint   `json:"folder_id"`                             // 0이면 폴더 없는 즐겨찾기
+	SentenceIDs []uint `json:"sentence_ids" binding:"required,min=1"` // 폴더 안 모든 즐겨찾기의 새 순서
+}
+
+// FolderRequest 폴더 생성/이름 변경 요청
+type FolderRequest struct {
+	Name string `json:"name" binding:"required,max=50"`
+}
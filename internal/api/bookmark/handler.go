@@ -0,0 +1,384 @@
+package bookmark
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jptaku/server/internal/middleware"
+	"github.com/jptaku/server/internal/model"
+	"github.com/jptaku/server/internal/pkg"
+	bookmarkSvc "github.com/jptaku/server/internal/service/bookmark"
+)
+
+type Handler struct {
+	bookmarkService bookmarkSvc.Provider
+}
+
+func NewHandler(bookmarkService bookmarkSvc.Provider) *Handler {
+	return &Handler{bookmarkService: bookmarkService}
+}
+
+func (h *Handler) RegisterRoutes(r *gin.RouterGroup, authMiddleware gin.HandlerFunc) {
+	bookmarks := r.Group("/sentences/bookmarks")
+	bookmarks.Use(authMiddleware)
+	{
+		bookmarks.GET("", h.ListBookmarks)
+		bookmarks.POST("", h.AddBookmark)
+		bookmarks.PUT("/order", h.ReorderBookmarks)
+		bookmarks.PATCH("/:sentenceId", h.UpdateBookmark)
+		bookmarks.DELETE("/:sentenceId", h.RemoveBookmark)
+
+		bookmarks.GET("/folders", h.ListFolders)
+		bookmarks.POST("/folders", h.CreateFolder)
+		bookmarks.PATCH("/folders/:folderId", h.RenameFolder)
+		bookmarks.DELETE("/folders/:folderId", h.DeleteFolder)
+	}
+}
+
+// ListBookmarks godoc
+// @Summary 즐겨찾기 목록 조회
+// @Description 폴더를 지정하면 폴더 안 순서대로, 아니면 최근 추가한 순으로 조회합니다. folder_id=0이면 폴더 없는 즐겨찾기만 조회합니다.
+// @Tags Bookmarks
+// @Security BearerAuth
+// @Produce json
+// @Param page query int false "페이지 번호" default(1)
+// @Param per_page query int false "페이지당 개수" default(20)
+// @Param folder_id query int false "폴더 ID (0: 폴더 없음)"
+// @Param sub_category query int false "SubCategory"
+// @Param level query int false "레벨 (0~3)"
+// @Success 200 {object} pkg.PaginatedResponse
+// @Router /api/sentences/bookmarks [get]
+func (h *Handler) ListBookmarks(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		pkg.UnauthorizedResponse(c, "")
+		return
+	}
+
+	var query ListQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		pkg.BadRequestResponse(c, err.Error())
+		return
+	}
+	if query.Page == 0 {
+		query.Page = 1
+	}
+	if query.PerPage == 0 {
+		query.PerPage = 20
+	}
+
+	filter := &model.BookmarkFilter{
+		FolderID:    query.FolderID,
+		SubCategory: query.SubCategory,
+		Level:       query.Level,
+	}
+	bookmarks, total, err := h.bookmarkService.ListBookmarks(userID, filter, query.Page, query.PerPage)
+	if err != nil {
+		pkg.InternalServerErrorResponse(c, "즐겨찾기 목록을 불러오는데 실패했습니다")
+		return
+	}
+
+	pkg.PaginatedSuccessResponse(c, bookmarks, query.Page, query.PerPage, total)
+}
+
+// AddBookmark godoc
+// @Summary 즐겨찾기 추가
+// @Description 문장을 즐겨찾기에 추가합니다. 폴더를 지정하면 그 폴더의 맨 뒤에 추가됩니다.
+// @Tags Bookmarks
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param request body AddBookmarkRequest true "즐겨찾기"
+// @Success 201 {object} model.SentenceBookmark
+// @Router /api/sentences/bookmarks [post]
+func (h *Handler) AddBookmark(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		pkg.UnauthorizedResponse(c, "")
+		return
+	}
+
+	var req AddBookmarkRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		pkg.BadRequestResponse(c, err.Error())
+		return
+	}
+
+	bookmark, err := h.bookmarkService.AddBookmark(userID, &bookmarkSvc.AddBookmarkInput{
+		SentenceID: req.SentenceID,
+		FolderID:   req.FolderID,
+		Note:       req.Note,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, pkg.ErrNotFound):
+			pkg.NotFoundResponse(c, "문장 또는 폴더를 찾을 수 없습니다")
+		case errors.Is(err, pkg.ErrAlreadyBookmarked):
+			pkg.ErrorResponse(c, http.StatusConflict, "이미 즐겨찾기한 문장입니다")
+		default:
+			pkg.InternalServerErrorResponse(c, "즐겨찾기 추가 실패")
+		}
+		return
+	}
+
+	pkg.CreatedResponse(c, bookmark)
+}
+
+// UpdateBookmark godoc
+// @Summary 즐겨찾기 수정
+// @Description 메모를 바꾸거나 다른 폴더로 옮깁니다 (folder_id=0이면 폴더에서 꺼냄). 보낸 필드만 변경됩니다.
+// @Tags Bookmarks
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param sentenceId path int true "문장 ID"
+// @Param request body UpdateBookmarkRequest true "수정 내용"
+// @Success 200 {object} model.SentenceBookmark
+// @Router /api/sentences/bookmarks/{sentenceId} [patch]
+func (h *Handler) UpdateBookmark(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		pkg.UnauthorizedResponse(c, "")
+		return
+	}
+
+	sentenceID, err := strconv.ParseUint(c.Param("sentenceId"), 10, 32)
+	if err != nil {
+		pkg.BadRequestResponse(c, "유효하지 않은 문장 ID입니다")
+		return
+	}
+
+	var req UpdateBookmarkRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		pkg.BadRequestResponse(c, err.Error())
+		return
+	}
+
+	bookmark, err := h.bookmarkService.UpdateBookmark(userID, uint(sentenceID), &bookmarkSvc.UpdateBookmarkInput{
+		FolderID: req.FolderID,
+		Note:     req.Note,
+	})
+	if err != nil {
+		if errors.Is(err, pkg.ErrNotFound) {
+			pkg.NotFoundResponse(c, "즐겨찾기 또는 폴더를 찾을 수 없습니다")
+			return
+		}
+		pkg.InternalServerErrorResponse(c, "즐겨찾기 수정 실패")
+		return
+	}
+
+	pkg.SuccessResponse(c, bookmark)
+}
+
+// RemoveBookmark godoc
+// @Summary 즐겨찾기 해제
+// @Tags Bookmarks
+// @Security BearerAuth
+// @Produce json
+// @Param sentenceId path int true "문장 ID"
+// @Success 200 {object} pkg.Response
+// @Router /api/sentences/bookmarks/{sentenceId} [delete]
+func (h *Handler) RemoveBookmark(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		pkg.UnauthorizedResponse(c, "")
+		return
+	}
+
+	sentenceID, err := strconv.ParseUint(c.Param("sentenceId"), 10, 32)
+	if err != nil {
+		pkg.BadRequestResponse(c, "유효하지 않은 문장 ID입니다")
+		return
+	}
+
+	if err := h.bookmarkService.RemoveBookmark(userID, uint(sentenceID)); err != nil {
+		if errors.Is(err, pkg.ErrNotFound) {
+			pkg.NotFoundResponse(c, "즐겨찾기하지 않은 문장입니다")
+			return
+		}
+		pkg.InternalServerErrorResponse(c, "즐겨찾기 해제 실패")
+		return
+	}
+
+	pkg.SuccessMessageResponse(c, "즐겨찾기를 해제했습니다")
+}
+
+// ReorderBookmarks godoc
+// @Summary 즐겨찾기 순서 변경
+// @Description 한 폴더(folder_id=0이면 폴더 없음) 안의 모든 즐겨찾기 문장 ID를 새 순서대로 보냅니다.
+// @Tags Bookmarks
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param request body ReorderRequest true "새 순서"
+// @Success 200 {object} pkg.Response
+// @Router /api/sentences/bookmarks/order [put]
+func (h *Handler) ReorderBookmarks(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		pkg.UnauthorizedResponse(c, "")
+		return
+	}
+
+	var req ReorderRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		pkg.BadRequestResponse(c, err.Error())
+		return
+	}
+
+	err := h.bookmarkService.ReorderBookmarks(userID, &bookmarkSvc.ReorderBookmarksInput{
+		FolderID:    req.FolderID,
+		SentenceIDs: req.SentenceIDs,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, pkg.ErrNotFound):
+			pkg.NotFoundResponse(c, "폴더를 찾을 수 없습니다")
+		case errors.Is(err, pkg.ErrBadRequest):
+			pkg.BadRequestResponse(c, "sentence_ids는 폴더 안의 모든 즐겨찾기를 한 번씩 포함해야 합니다")
+		default:
+			pkg.InternalServerErrorResponse(c, "즐겨찾기 순서 변경 실패")
+		}
+		return
+	}
+
+	pkg.SuccessMessageResponse(c, "순서를 변경했습니다")
+}
+
+// ListFolders godoc
+// @Summary 즐겨찾기 폴더 목록
+// @Tags Bookmarks
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {array} model.BookmarkFolder
+// @Router /api/sentences/bookmarks/folders [get]
+func (h *Handler) ListFolders(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		pkg.UnauthorizedResponse(c, "")
+		return
+	}
+
+	folders, err := h.bookmarkService.ListFolders(userID)
+	if err != nil {
+		pkg.InternalServerErrorResponse(c, "폴더 목록을 불러오는데 실패했습니다")
+		return
+	}
+
+	pkg.SuccessResponse(c, folders)
+}
+
+// CreateFolder godoc
+// @Summary 즐겨찾기 폴더 생성
+// @Tags Bookmarks
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param request body FolderRequest true "폴더 이름"
+// @Success 201 {object} model.BookmarkFolder
+// @Router /api/sentences/bookmarks/folders [post]
+func (h *Handler) CreateFolder(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		pkg.UnauthorizedResponse(c, "")
+		return
+	}
+
+	var req FolderRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		pkg.BadRequestResponse(c, err.Error())
+		return
+	}
+
+	folder, err := h.bookmarkService.CreateFolder(userID, req.Name)
+	if err != nil {
+		h.folderError(c, err, "폴더 생성 실패")
+		return
+	}
+
+	pkg.CreatedResponse(c, folder)
+}
+
+// RenameFolder godoc
+// @Summary 즐겨찾기 폴더 이름 변경
+// @Tags Bookmarks
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param folderId path int true "폴더 ID"
+// @Param request body FolderRequest true "새 이름"
+// @Success 200 {object} model.BookmarkFolder
+// @Router /api/sentences/bookmarks/folders/{folderId} [patch]
+func (h *Handler) RenameFolder(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		pkg.UnauthorizedResponse(c, "")
+		return
+	}
+
+	folderID, err := strconv.ParseUint(c.Param("folderId"), 10, 32)
+	if err != nil {
+		pkg.BadRequestResponse(c, "유효하지 않은 폴더 ID입니다")
+		return
+	}
+
+	var req FolderRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		pkg.BadRequestResponse(c, err.Error())
+		return
+	}
+
+	folder, err := h.bookmarkService.RenameFolder(userID, uint(folderID), req.Name)
+	if err != nil {
+		h.folderError(c, err, "폴더 이름 변경 실패")
+		return
+	}
+
+	pkg.SuccessResponse(c, folder)
+}
+
+// DeleteFolder godoc
+// @Summary 즐겨찾기 폴더 삭제
+// @Description 폴더 안의 즐겨찾기는 삭제되지 않고 폴더 없음으로 옮겨집니다.
+// @Tags Bookmarks
+// @Security BearerAuth
+// @Produce json
+// @Param folderId path int true "폴더 ID"
+// @Success 200 {object} pkg.Response
+// @Router /api/sentences/bookmarks/folders/{folderId} [delete]
+func (h *Handler) DeleteFolder(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		pkg.UnauthorizedResponse(c, "")
+		return
+	}
+
+	folderID, err := strconv.ParseUint(c.Param("folderId"), 10, 32)
+	if err != nil {
+		pkg.BadRequestResponse(c, "유효하지 않은 폴더 ID입니다")
+		return
+	}
+
+	if err := h.bookmarkService.DeleteFolder(userID, uint(folderID)); err != nil {
+		h.folderError(c, err, "폴더 삭제 실패")
+		return
+	}
+
+	pkg.SuccessMessageResponse(c, "폴더를 삭제했습니다")
+}
+
+// folderError 폴더 관련 에러 응답
+func (h *Handler) folderError(c *gin.Context, err error, fallback string) {
+	switch {
+	case errors.Is(err, pkg.ErrNotFound):
+		pkg.NotFoundResponse(c, "폴더를 찾을 수 없습니다")
+	case errors.Is(err, pkg.ErrDuplicateFolder):
+		pkg.ErrorResponse(c, http.StatusConflict, "같은 이름의 폴더가 이미 있습니다")
+	case errors.Is(err, pkg.ErrBadRequest):
+		pkg.BadRequestResponse(c, "폴더 이름이 비어 있거나 폴더 수 제한을 넘었습니다")
+	default:
+		pkg.InternalServerErrorResponse(c, fallback)
+	}
+}
@@ -1,5 +1,11 @@
 package learning
 
+import (
+	"time"
+
+	"github.com/jptaku/server/internal/pkg"
+)
+
 type UpdateProgressRequest struct {
 	SentenceID uint  `json:"sentence_id" binding:"required"`
 	DailySetID uint  `json:"daily_set_id"`
@@ -19,18 +25,57 @@ type ProgressQuery struct {
 }
 
 // SubmitQuizRequest 퀴즈 제출 요청
+// 유형별 답은 answers로 보내고, fill_blank_answer/ordering_answer는 기존 클라이언트 호환용입니다.
+// 같은 유형이 양쪽에 있으면 answers가 우선합니다.
 type SubmitQuizRequest struct {
-	SentenceID      uint   `json:"sentence_id" binding:"required"`
-	DailySetID      uint   `json:"daily_set_id"`
-	FillBlankAnswer string `json:"fill_blank_answer" binding:"required"` // 빈칸 채우기 정답
-	OrderingAnswer  []int  `json:"ordering_answer" binding:"required"`   // 문장 배열 정답 (인덱스 배열)
+	SentenceID      uint                  `json:"sentence_id" binding:"required"`
+	DailySetID      uint                  `json:"daily_set_id"`
+	Answers         map[string]QuizAnswer `json:"answers" binding:"max=6,dive"`        // 유형(fill_blank, ordering, meaning, listening, dictation, reading)별 답
+	FillBlankAnswer string                `json:"fill_blank_answer" binding:"max=255"` // 빈칸 채우기 답 (answers.fill_blank.text와 같음)
+	OrderingAnswer  []int                 `json:"ordering_answer" binding:"max=50"`    // 문장 배열 답안 (answers.ordering.order와 같음)
+	TimeSpent       int                   `json:"time_spent_seconds" binding:"min=0"`  // 풀이 소요 시간 (초)
+}
+
+// IsLegacy answers 없이 기존 필드만 보낸 요청인지 (유형이 추가되기 전 클라이언트)
+func (r *SubmitQuizRequest) IsLegacy() bool {
+	return len(r.Answers) == 0 && (r.FillBlankAnswer != "" || r.OrderingAnswer != nil)
+}
+
+// QuizAnswer 유형별 답 (유형에 따라 한 필드만 사용)
+// 채점 시 답과 정답의 차이를 계산하므로 길이를 제한합니다.
+type QuizAnswer struct {
+	Text  string   `json:"text,omitempty" binding:"max=200"`             // 빈칸 채우기/뜻 고르기/듣기: 고른 보기, 받아쓰기: 입력한 문장
+	Texts []string `json:"texts,omitempty" binding:"max=10,dive,max=50"` // 단어 읽기: words 순서대로 입력한 읽는 법
+	Order []int    `json:"order,omitempty" binding:"max=50"`             // 문장 배열: 응답으로 받은 fragments의 인덱스 배열
 }
 
 // SubmitQuizResponse 퀴즈 제출 응답
 type SubmitQuizResponse struct {
-	SentenceID       uint `json:"sentence_id"`
-	FillBlankCorrect bool `json:"fill_blank_correct"` // 빈칸 채우기 정답 여부
-	OrderingCorrect  bool `json:"ordering_correct"`   // 문장 배열 정답 여부
-	AllCorrect       bool `json:"all_correct"`        // 모두 정답 여부
-	Memorized        bool `json:"memorized"`          // 암기 완료 여부
+	SentenceID       uint               `json:"sentence_id"`
+	Parts            []QuizPartResponse `json:"parts"`                    // 채점한 유형별 결과
+	FillBlankCorrect bool               `json:"fill_blank_correct"`       // 빈칸 채우기 정답 여부
+	OrderingCorrect  bool               `json:"ordering_correct"`         // 문장 배열 정답 여부
+	Complete         bool               `json:"complete"`                 // 문장에 있는 모든 유형을 제출했는지 (아니면 암기/복습에 반영하지 않음)
+	AllCorrect       bool               `json:"all_correct"`              // 모든 유형을 제출해 모두 맞혔는지
+	Memorized        bool               `json:"memorized"`                // 암기 완료 여부
+	AttemptNo        int                `json:"attempt_no"`               // 이 문장에서 몇 번째 시도인지
+	FillBlankAnswer  string             `json:"fill_blank_answer"`        // 빈칸 채우기 정답
+	OrderingAnswer   []int              `json:"ordering_answer"`          // 문장 배열 정답 순서 (fragments의 인덱스)
+	NextReviewAt     *time.Time         `json:"next_review_at,omitempty"` // 다음 복습 시각
+}
+
+// QuizPartResponse 유형별 채점 결과
+type QuizPartResponse struct {
+	Type          string              `json:"type"`
+	Correct       bool                `json:"correct"`         // 정답 여부 (표기만 다른 답 포함)
+	Match         string              `json:"match"`           // exact, acceptable(표기만 다름), wrong
+	CorrectAnswer QuizAnswer          `json:"correct_answer"`  // 정답 (문장 배열은 fragments의 인덱스)
+	Diffs         [][]pkg.DiffSegment `json:"diffs,omitempty"` // 입력한 답과 정답의 차이 (단어 읽기는 문항별)
+}
+
+// QuizAttemptsQuery 퀴즈 시도 기록 조회 조건
+type QuizAttemptsQuery struct {
+	SentenceID uint `form:"sentence_id"` // 없으면 전체 문장
+	Page       int  `form:"page" binding:"omitempty,min=1"`
+	PerPage    int  `form:"per_page" binding:"omitempty,min=1,max=50"`
 }
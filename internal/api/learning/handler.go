@@ -1,8 +1,12 @@
 package learning
 
 import (
+	"errors"
+	"fmt"
+
 	"github.com/gin-gonic/gin"
 	"github.com/jptaku/server/internal/middleware"
+	"github.com/jptaku/server/internal/model"
 	"github.com/jptaku/server/internal/pkg"
 	learningSvc "github.com/jptaku/server/internal/service/learning"
 )
@@ -21,6 +25,7 @@ func (h *Handler) RegisterRoutes(r *gin.RouterGroup, authMiddleware gin.HandlerF
 	{
 		learning.POST("/progress", h.UpdateProgress)
 		learning.POST("/quiz", h.SubmitQuiz)
+		learning.GET("/quiz/attempts", h.GetQuizAttempts)
 		learning.GET("/today", h.GetTodayProgress)
 		learning.GET("/history", h.GetProgressHistory)
 	}
@@ -132,13 +137,15 @@ func (h *Handler) GetProgressHistory(c *gin.Context) {
 
 // SubmitQuiz godoc
 // @Summary 퀴즈 제출
-// @Description 빈칸 채우기/문장 배열 퀴즈 정답 제출 및 검증. 모두 맞으면 해당 문장 암기 완료로 표시
+// @Description 유형별(빈칸 채우기, 문장 배열, 뜻 고르기, 듣기, 받아쓰기, 단어 읽기) 퀴즈 답안 제출 및 채점. 푼 유형을 answers에 담아 보내며, 정답은 제출 후 응답으로만 공개됩니다. 문장에 있는 모든 유형을 제출해 모두 맞으면 해당 문장 암기 완료로 표시하고, 결과에 따라 다음 복습 일정을 계산합니다. 일부 유형만 제출하면 시도 기록만 남깁니다. answers 없이 fill_blank_answer/ordering_answer만 보내는 기존 클라이언트는 두 유형만 제출해도 완료로 봅니다
 // @Tags Learning
 // @Security BearerAuth
 // @Accept json
 // @Produce json
 // @Param request body SubmitQuizRequest true "퀴즈 답안"
 // @Success 200 {object} SubmitQuizResponse
+// @Failure 400 {object} pkg.Response
+// @Failure 404 {object} pkg.Response
 // @Router /api/learning/quiz [post]
 func (h *Handler) SubmitQuiz(c *gin.Context) {
 	userID := middleware.GetUserID(c)
@@ -153,26 +160,123 @@ func (h *Handler) SubmitQuiz(c *gin.Context) {
 		return
 	}
 
+	answers, err := quizAnswers(&req)
+	if err != nil {
+		pkg.BadRequestResponse(c, err.Error())
+		return
+	}
+
 	input := &learningSvc.SubmitQuizInput{
-		SentenceID:      req.SentenceID,
-		DailySetID:      req.DailySetID,
-		FillBlankAnswer: req.FillBlankAnswer,
-		OrderingAnswer:  req.OrderingAnswer,
+		SentenceID: req.SentenceID,
+		DailySetID: req.DailySetID,
+		Answers:    answers,
+		TimeSpent:  req.TimeSpent,
+		Legacy:     req.IsLegacy(),
 	}
 
 	result, err := h.learningService.SubmitQuiz(userID, input)
 	if err != nil {
-		pkg.InternalServerErrorResponse(c, "퀴즈 제출 실패")
+		switch {
+		case errors.Is(err, pkg.ErrNotFound):
+			pkg.NotFoundResponse(c, "퀴즈를 찾을 수 없습니다")
+		case errors.Is(err, pkg.ErrBadRequest):
+			pkg.BadRequestResponse(c, "채점할 답안이 없습니다")
+		default:
+			pkg.InternalServerErrorResponse(c, "퀴즈 제출 실패")
+		}
 		return
 	}
 
+	parts := make([]QuizPartResponse, len(result.Parts))
+	for i, part := range result.Parts {
+		parts[i] = QuizPartResponse{
+			Type:    string(part.Type),
+			Correct: part.Correct,
+			Match:   string(part.Match),
+			CorrectAnswer: QuizAnswer{
+				Text:  part.CorrectAnswer.Text,
+				Texts: part.CorrectAnswer.Texts,
+				Order: part.CorrectAnswer.Order,
+			},
+			Diffs: part.Diffs,
+		}
+	}
+
 	response := SubmitQuizResponse{
 		SentenceID:       result.SentenceID,
+		Parts:            parts,
 		FillBlankCorrect: result.FillBlankCorrect,
 		OrderingCorrect:  result.OrderingCorrect,
+		Complete:         result.Complete,
 		AllCorrect:       result.AllCorrect,
 		Memorized:        result.Memorized,
+		AttemptNo:        result.AttemptNo,
+		FillBlankAnswer:  result.FillBlankAnswer,
+		OrderingAnswer:   result.OrderingAnswer,
+		NextReviewAt:     result.NextReviewAt,
 	}
 
 	pkg.SuccessResponse(c, response)
 }
+
+// GetQuizAttempts godoc
+// @Summary 퀴즈 시도 기록
+// @Description 제출한 퀴즈 답안과 파트별 정답 여부, 풀이 시간, 시도 번호를 최근 순으로 조회. 문장 배열 답안은 원래 조각 인덱스로 기록됩니다
+// @Tags Learning
+// @Security BearerAuth
+// @Produce json
+// @Param sentence_id query int false "문장 ID (없으면 전체)"
+// @Param page query int false "페이지 번호" default(1)
+// @Param per_page query int false "페이지당 개수" default(20)
+// @Success 200 {object} pkg.PaginatedResponse
+// @Router /api/learning/quiz/attempts [get]
+func (h *Handler) GetQuizAttempts(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		pkg.UnauthorizedResponse(c, "")
+		return
+	}
+
+	var query QuizAttemptsQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		pkg.BadRequestResponse(c, err.Error())
+		return
+	}
+	if query.Page == 0 {
+		query.Page = 1
+	}
+	if query.PerPage == 0 {
+		query.PerPage = 20
+	}
+
+	attempts, total, err := h.learningService.GetQuizAttempts(userID, query.SentenceID, query.Page, query.PerPage)
+	if err != nil {
+		pkg.InternalServerErrorResponse(c, "퀴즈 기록을 불러오는데 실패했습니다")
+		return
+	}
+
+	pkg.PaginatedSuccessResponse(c, attempts, query.Page, query.PerPage, total)
+}
+
+// quizAnswers 요청의 답안을 유형별 답으로 변환 (기존 fill_blank_answer/ordering_answer 포함)
+func quizAnswers(req *SubmitQuizRequest) (map[pkg.QuizType]model.QuizAnswer, error) {
+	answers := make(map[pkg.QuizType]model.QuizAnswer, len(req.Answers)+2)
+	if req.FillBlankAnswer != "" {
+		answers[pkg.QuizTypeFillBlank] = model.QuizAnswer{Text: req.FillBlankAnswer}
+	}
+	if req.OrderingAnswer != nil {
+		answers[pkg.QuizTypeOrdering] = model.QuizAnswer{Order: req.OrderingAnswer}
+	}
+	for key, answer := range req.Answers {
+		quizType := pkg.QuizType(key)
+		if !quizType.IsValid() {
+			return nil, fmt.Errorf("알 수 없는 퀴즈 유형입니다: %s", key)
+		}
+		answers[quizType] = model.QuizAnswer{
+			Text:  answer.Text,
+			Texts: answer.Texts,
+			Order: answer.Order,
+		}
+	}
+	return answers, nil
+}
@@ -0,0 +1,23 @@
+package meta
+
+import "github.com/jptaku/server/internal/pkg"
+
+// TaxonomyResponse 전체 분류 체계
+type TaxonomyResponse struct {
+	Categories []CategoryResponse `json:"categories"`
+	Levels     []CodeName         `json:"levels"`
+	Purposes   []CodeName         `json:"purposes"`
+}
+
+// CategoryResponse 분야와 그 아래 SubCategory (관심사)
+type CategoryResponse struct {
+	Code          string            `json:"code"` // anime, game, music, vtuber, lifestyle, situation
+	Name          pkg.LocalizedName `json:"name"`
+	SubCategories []CodeName        `json:"sub_categories"`
+}
+
+// CodeName 코드와 언어별 이름 (온보딩/필터에는 code 값을 보냅니다)
+type CodeName struct {
+	Code int               `json:"code"`
+	Name pkg.LocalizedName `json:"name"`
+}
@@ -0,0 +1,161 @@
+package meta
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jptaku/server/internal/pkg"
+)
+
+// cacheControl 분류 체계는 배포 때만 바뀌므로 클라이언트/CDN 캐시를 허용
+const cacheControl = "public, max-age=3600"
+
+// cachedResponse 미리 직렬화한 응답과 ETag
+type cachedResponse struct {
+	body []byte
+	etag string
+}
+
+type Handler struct {
+	taxonomy  *cachedResponse
+	interests *cachedResponse
+	levels    *cachedResponse
+	purposes  *cachedResponse
+}
+
+// NewHandler 분류 체계(pkg/categories.go, pkg/taxonomy.go)로 응답을 한 번만 만들어 둡니다.
+func NewHandler() *Handler {
+	taxonomy := buildTaxonomy()
+	return &Handler{
+		taxonomy:  newCachedResponse(taxonomy),
+		interests: newCachedResponse(taxonomy.Categories),
+		levels:    newCachedResponse(taxonomy.Levels),
+		purposes:  newCachedResponse(taxonomy.Purposes),
+	}
+}
+
+func (h *Handler) RegisterRoutes(r *gin.RouterGroup) {
+	meta := r.Group("/meta")
+	{
+		meta.GET("", h.GetTaxonomy)
+		meta.GET("/interests", h.GetInterests)
+		meta.GET("/levels", h.GetLevels)
+		meta.GET("/purposes", h.GetPurposes)
+	}
+}
+
+// GetTaxonomy godoc
+// @Summary 분류 체계 조회
+// @Description 분야 → SubCategory(관심사), 레벨, 학습 목적 전체를 한국어/일본어/영어 이름과 함께 반환합니다. ETag로 캐시할 수 있습니다.
+// @Tags Meta
+// @Produce json
+// @Param If-None-Match header string false "이전 응답의 ETag"
+// @Success 200 {object} TaxonomyResponse
+// @Success 304
+// @Router /api/meta [get]
+func (h *Handler) GetTaxonomy(c *gin.Context) {
+	serveCached(c, h.taxonomy)
+}
+
+// GetInterests godoc
+// @Summary 관심사 목록 조회
+// @Description 분야별 SubCategory 목록 (온보딩 interests 코드)
+// @Tags Meta
+// @Produce json
+// @Param If-None-Match header string false "이전 응답의 ETag"
+// @Success 200 {array} CategoryResponse
+// @Success 304
+// @Router /api/meta/interests [get]
+func (h *Handler) GetInterests(c *gin.Context) {
+	serveCached(c, h.interests)
+}
+
+// GetLevels godoc
+// @Summary 레벨 목록 조회
+// @Tags Meta
+// @Produce json
+// @Param If-None-Match header string false "이전 응답의 ETag"
+// @Success 200 {array} CodeName
+// @Success 304
+// @Router /api/meta/levels [get]
+func (h *Handler) GetLevels(c *gin.Context) {
+	serveCached(c, h.levels)
+}
+
+// GetPurposes godoc
+// @Summary 학습 목적 목록 조회
+// @Tags Meta
+// @Produce json
+// @Param If-None-Match header string false "이전 응답의 ETag"
+// @Success 200 {array} CodeName
+// @Success 304
+// @Router /api/meta/purposes [get]
+func (h *Handler) GetPurposes(c *gin.Context) {
+	serveCached(c, h.purposes)
+}
+
+func buildTaxonomy() *TaxonomyResponse {
+	taxonomy := &TaxonomyResponse{
+		Categories: make([]CategoryResponse, 0, len(pkg.SubCategoryGroups)),
+		Levels:     make([]CodeName, 0, len(pkg.AllLevels)),
+		Purposes:   make([]CodeName, 0, len(pkg.AllPurposes)),
+	}
+
+	for _, group := range pkg.SubCategoryGroups {
+		subCategories := make([]CodeName, 0, len(group.SubCategories))
+		for _, subCategory := range group.SubCategories {
+			subCategories = append(subCategories, CodeName{Code: int(subCategory), Name: subCategory.LocalizedName()})
+		}
+		taxonomy.Categories = append(taxonomy.Categories, CategoryResponse{
+			Code:          group.Code,
+			Name:          group.Name,
+			SubCategories: subCategories,
+		})
+	}
+	for _, level := range pkg.AllLevels {
+		taxonomy.Levels = append(taxonomy.Levels, CodeName{Code: int(level), Name: level.LocalizedName()})
+	}
+	for _, purpose := range pkg.AllPurposes {
+		taxonomy.Purposes = append(taxonomy.Purposes, CodeName{Code: int(purpose), Name: purpose.LocalizedName()})
+	}
+
+	return taxonomy
+}
+
+func newCachedResponse(data interface{}) *cachedResponse {
+	body, err := json.Marshal(pkg.Response{Success: true, Data: data})
+	if err != nil {
+		panic(err) // 정적 데이터라 실패하지 않음
+	}
+	sum := sha256.Sum256(body)
+	return &cachedResponse{
+		body: body,
+		etag: `"` + hex.EncodeToString(sum[:8]) + `"`,
+	}
+}
+
+// serveCached If-None-Match가 ETag와 같으면 304, 아니면 본문 반환
+func serveCached(c *gin.Context, res *cachedResponse) {
+	c.Header("ETag", res.etag)
+	c.Header("Cache-Control", cacheControl)
+
+	if etagMatches(c.GetHeader("If-None-Match"), res.etag) {
+		c.Status(http.StatusNotModified)
+		return
+	}
+	c.Data(http.StatusOK, "application/json; charset=utf-8", res.body)
+}
+
+func etagMatches(ifNoneMatch, etag string) bool {
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == etag || candidate == "*" {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,11 @@
+package review
+
+// DueQuery 복습 목록 조회 쿼리
+type DueQuery struct {
+	Limit int `form:"limit" binding:"omitempty,min=1,max=100"`
+}
+
+// GradeRequest 복습 평가 요청
+type GradeRequest struct {
+	Grade string `json:"grade" binding:"required"` // again, hard, good, easy
+}
@@ -0,0 +1,111 @@
+package review
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jptaku/server/internal/middleware"
+	"github.com/jptaku/server/internal/pkg"
+	reviewSvc "github.com/jptaku/server/internal/service/review"
+)
+
+type Handler struct {
+	reviewService reviewSvc.Provider
+}
+
+func NewHandler(reviewService reviewSvc.Provider) *Handler {
+	return &Handler{reviewService: reviewService}
+}
+
+func (h *Handler) RegisterRoutes(r *gin.RouterGroup, authMiddleware gin.HandlerFunc) {
+	review := r.Group("/review")
+	review.Use(authMiddleware)
+	{
+		review.GET("/due", h.GetDue)
+		review.POST("/:sentenceId/grade", h.Grade)
+	}
+}
+
+// GetDue godoc
+// @Summary 오늘 복습할 문장 조회
+// @Description 간격 반복(SM-2) 일정상 오늘(사용자 타임존 기준) 복습할 차례가 된 문장을 오래 밀린 순으로 조회합니다.
+// @Tags Review
+// @Security BearerAuth
+// @Produce json
+// @Param limit query int false "최대 개수" default(20)
+// @Success 200 {object} reviewSvc.DueReviewsResponse
+// @Router /api/review/due [get]
+func (h *Handler) GetDue(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		pkg.UnauthorizedResponse(c, "")
+		return
+	}
+
+	var query DueQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		pkg.BadRequestResponse(c, "limit은 1~100 사이여야 합니다")
+		return
+	}
+	if query.Limit == 0 {
+		query.Limit = 20
+	}
+
+	result, err := h.reviewService.GetDue(userID, query.Limit)
+	if err != nil {
+		pkg.InternalServerErrorResponse(c, "복습 목록을 불러오는데 실패했습니다")
+		return
+	}
+
+	pkg.SuccessResponse(c, result)
+}
+
+// Grade godoc
+// @Summary 복습 평가
+// @Description 복습한 문장을 again/hard/good/easy로 평가하면 다음 복습 일정이 계산됩니다. 복습 차례 전의 평가는 연습(practice_count)으로만 기록됩니다.
+// @Tags Review
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param sentenceId path int true "문장 ID"
+// @Param request body GradeRequest true "평가"
+// @Success 200 {object} model.SentenceReview
+// @Router /api/review/{sentenceId}/grade [post]
+func (h *Handler) Grade(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		pkg.UnauthorizedResponse(c, "")
+		return
+	}
+
+	sentenceID, err := strconv.ParseUint(c.Param("sentenceId"), 10, 32)
+	if err != nil {
+		pkg.BadRequestResponse(c, "유효하지 않은 문장 ID입니다")
+		return
+	}
+
+	var req GradeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		pkg.BadRequestResponse(c, err.Error())
+		return
+	}
+
+	grade, ok := pkg.ParseReviewGrade(req.Grade)
+	if !ok {
+		pkg.BadRequestResponse(c, "grade는 again, hard, good, easy 중 하나여야 합니다")
+		return
+	}
+
+	review, err := h.reviewService.Grade(userID, uint(sentenceID), grade)
+	if err != nil {
+		if errors.Is(err, pkg.ErrNotFound) {
+			pkg.NotFoundResponse(c, "복습 일정이 없는 문장입니다")
+			return
+		}
+		pkg.InternalServerErrorResponse(c, "복습 평가 저장 실패")
+		return
+	}
+
+	pkg.SuccessResponse(c, review)
+}
@@ -8,23 +8,46 @@ type WordResponse struct {
 	PartOf   string `json:"part_of"`
 }
 
-// QuizFillBlankResponse 빈칸 채우기 퀴즈
+// QuizFillBlankResponse 빈칸 채우기 퀴즈 (정답은 제출 후 공개)
 type QuizFillBlankResponse struct {
 	QuestionJP string   `json:"question_jp"`
-	Options    []string `json:"options"`
-	Answer     string   `json:"answer"`
+	Options    []string `json:"options"` // 사용자별로 섞인 보기
 }
 
-// QuizOrderingResponse 문장 배열 퀴즈
+// QuizOrderingResponse 문장 배열 퀴즈 (정답은 제출 후 공개)
 type QuizOrderingResponse struct {
-	Fragments    []string `json:"fragments"`
-	CorrectOrder []int    `json:"correct_order"`
+	Fragments []string `json:"fragments"` // 사용자별로 섞인 조각 (답안은 이 배열의 인덱스로 제출)
 }
 
-// QuizResponse 퀴즈 응답
+// QuizChoiceResponse 한국어 뜻 고르기 퀴즈 (정답은 제출 후 공개)
+type QuizChoiceResponse struct {
+	Options []string `json:"options"` // 사용자별로 섞인 보기 (답안은 고른 보기 문자열로 제출)
+}
+
+// QuizListeningResponse 듣기 퀴즈 (음성을 듣고 문장 고르기)
+type QuizListeningResponse struct {
+	AudioURL string   `json:"audio_url"`
+	Options  []string `json:"options"` // 사용자별로 섞인 보기
+}
+
+// QuizDictationResponse 받아쓰기 퀴즈 (음성을 듣고 문장 입력)
+type QuizDictationResponse struct {
+	AudioURL string `json:"audio_url"`
+}
+
+// QuizReadingResponse 단어 읽기 퀴즈 (답안은 words 순서대로 가나로 제출)
+type QuizReadingResponse struct {
+	Words []string `json:"words"`
+}
+
+// QuizResponse 퀴즈 응답 (문장에 있는 유형만 포함)
 type QuizResponse struct {
 	FillBlank *QuizFillBlankResponse `json:"fill_blank,omitempty"`
 	Ordering  *QuizOrderingResponse  `json:"ordering,omitempty"`
+	Meaning   *QuizChoiceResponse    `json:"meaning,omitempty"`
+	Listening *QuizListeningResponse `json:"listening,omitempty"`
+	Dictation *QuizDictationResponse `json:"dictation,omitempty"`
+	Reading   *QuizReadingResponse   `json:"reading,omitempty"`
 }
 
 // SentenceResponse 문장 + 상세 정보 응답
@@ -40,7 +63,9 @@ type SentenceResponse struct {
 	Grammar     []string       `json:"grammar"`
 	Examples    []string       `json:"examples"`
 	Quiz        *QuizResponse  `json:"quiz,omitempty"`
-	Memorized   bool           `json:"memorized"` // 암기 완료 여부
+	Memorized   bool           `json:"memorized"`  // 암기 완료 여부
+	Review      bool           `json:"review"`     // 복습할 차례가 되어 섞인 문장
+	Bookmarked  bool           `json:"bookmarked"` // 즐겨찾기 여부
 }
 
 // DailySentencesResponse 오늘의 5문장 응답
@@ -63,3 +88,13 @@ type HistorySentencesResponse struct {
 	Total      int64                 `json:"total"`
 	TotalPages int                   `json:"total_pages"`
 }
+
+// SearchQuery 문장 검색 쿼리
+type SearchQuery struct {
+	Q           string `form:"q" binding:"required,max=100"`
+	Scope       string `form:"scope" binding:"omitempty,oneof=all mine"`
+	Level       *int   `form:"level" binding:"omitempty,min=0,max=3"`
+	SubCategory *int   `form:"sub_category"`
+	Page        int    `form:"page" binding:"omitempty,min=1"`
+	PerPage     int    `form:"per_page" binding:"omitempty,min=1,max=50"`
+}
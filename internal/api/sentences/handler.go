@@ -1,6 +1,7 @@
 package sentences
 
 import (
+	"errors"
 	"strconv"
 
 	"github.com/gin-gonic/gin"
@@ -23,6 +24,7 @@ func (h *Handler) RegisterRoutes(r *gin.RouterGroup, authMiddleware gin.HandlerF
 	{
 		sentences.GET("/today", h.GetTodaySentences)
 		sentences.GET("/history", h.GetHistorySentences)
+		sentences.GET("/search", h.SearchSentences)
 	}
 }
 
@@ -88,53 +90,70 @@ func (h *Handler) GetHistorySentences(c *gin.Context) {
 	pkg.SuccessResponse(c, response)
 }
 
-func convertToResponse(result *sentence.DailySentencesResponse) *DailySentencesResponse {
-	sentences := make([]SentenceResponse, len(result.Sentences))
-	for i, s := range result.Sentences {
-		// Words 변환
-		words := make([]WordResponse, len(s.Words))
-		for j, w := range s.Words {
-			words[j] = WordResponse{
-				Japanese: w.Japanese,
-				Reading:  w.Reading,
-				Meaning:  w.Meaning,
-				PartOf:   w.PartOf,
-			}
-		}
+// SearchSentences godoc
+// @Summary 문장 검색
+// @Description 일본어 문장, 한국어 번역, 로마지, 단어 풀이에서 검색합니다. 반각/전각, 가타카나/히라가나 차이는 무시하고, 철자가 조금 달라도 비슷한 문장을 찾습니다.
+// @Tags Sentences
+// @Security BearerAuth
+// @Produce json
+// @Param q query string true "검색어"
+// @Param scope query string false "all: 전체 문장, mine: 내 데일리 세트에 나온 문장" default(all) Enums(all, mine)
+// @Param level query int false "레벨 (0~3)"
+// @Param sub_category query int false "SubCategory"
+// @Param page query int false "페이지 번호" default(1)
+// @Param per_page query int false "페이지당 개수" default(20)
+// @Success 200 {object} pkg.PaginatedResponse
+// @Router /api/sentences/search [get]
+func (h *Handler) SearchSentences(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		pkg.UnauthorizedResponse(c, "")
+		return
+	}
 
-		// Quiz 변환
-		var quiz *QuizResponse
-		if s.Quiz != nil {
-			quiz = &QuizResponse{}
-			if s.Quiz.FillBlank != nil {
-				quiz.FillBlank = &QuizFillBlankResponse{
-					QuestionJP: s.Quiz.FillBlank.QuestionJP,
-					Options:    s.Quiz.FillBlank.Options,
-					Answer:     s.Quiz.FillBlank.Answer,
-				}
-			}
-			if s.Quiz.Ordering != nil {
-				quiz.Ordering = &QuizOrderingResponse{
-					Fragments:    s.Quiz.Ordering.Fragments,
-					CorrectOrder: s.Quiz.Ordering.CorrectOrder,
-				}
-			}
-		}
+	var query SearchQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		pkg.BadRequestResponse(c, err.Error())
+		return
+	}
+	if query.Page == 0 {
+		query.Page = 1
+	}
+	if query.PerPage == 0 {
+		query.PerPage = 20
+	}
+	if query.Scope == "" {
+		query.Scope = sentence.SearchScopeAll
+	}
 
-		sentences[i] = SentenceResponse{
-			ID:          s.ID,
-			SentenceKey: s.SentenceKey,
-			JP:          s.JP,
-			KR:          s.KR,
-			Romaji:      s.Romaji,
-			Level:       s.Level,
-			SubCategory: s.SubCategory,
-			Words:       words,
-			Grammar:     s.Grammar,
-			Examples:    s.Examples,
-			Quiz:        quiz,
-			Memorized:   s.Memorized,
+	results, total, err := h.sentenceService.SearchSentences(userID, &sentence.SearchInput{
+		Query:       query.Q,
+		Scope:       query.Scope,
+		Level:       query.Level,
+		SubCategory: query.SubCategory,
+		Page:        query.Page,
+		PerPage:     query.PerPage,
+	})
+	if err != nil {
+		if errors.Is(err, pkg.ErrBadRequest) {
+			pkg.BadRequestResponse(c, "검색어를 입력해주세요")
+			return
 		}
+		pkg.InternalServerErrorResponse(c, "문장 검색 실패")
+		return
+	}
+
+	sentences := make([]SentenceResponse, len(results))
+	for i, s := range results {
+		sentences[i] = convertSentence(s)
+	}
+	pkg.PaginatedSuccessResponse(c, sentences, query.Page, query.PerPage, total)
+}
+
+func convertToResponse(result *sentence.DailySentencesResponse) *DailySentencesResponse {
+	sentences := make([]SentenceResponse, len(result.Sentences))
+	for i, s := range result.Sentences {
+		sentences[i] = convertSentence(s)
 	}
 
 	return &DailySentencesResponse{
@@ -149,50 +168,7 @@ func convertHistoryToResponse(result *sentence.HistorySentencesResponse) *Histor
 	for i, item := range result.History {
 		sentences := make([]SentenceResponse, len(item.Sentences))
 		for j, s := range item.Sentences {
-			// Words 변환
-			words := make([]WordResponse, len(s.Words))
-			for k, w := range s.Words {
-				words[k] = WordResponse{
-					Japanese: w.Japanese,
-					Reading:  w.Reading,
-					Meaning:  w.Meaning,
-					PartOf:   w.PartOf,
-				}
-			}
-
-			// Quiz 변환
-			var quiz *QuizResponse
-			if s.Quiz != nil {
-				quiz = &QuizResponse{}
-				if s.Quiz.FillBlank != nil {
-					quiz.FillBlank = &QuizFillBlankResponse{
-						QuestionJP: s.Quiz.FillBlank.QuestionJP,
-						Options:    s.Quiz.FillBlank.Options,
-						Answer:     s.Quiz.FillBlank.Answer,
-					}
-				}
-				if s.Quiz.Ordering != nil {
-					quiz.Ordering = &QuizOrderingResponse{
-						Fragments:    s.Quiz.Ordering.Fragments,
-						CorrectOrder: s.Quiz.Ordering.CorrectOrder,
-					}
-				}
-			}
-
-			sentences[j] = SentenceResponse{
-				ID:          s.ID,
-				SentenceKey: s.SentenceKey,
-				JP:          s.JP,
-				KR:          s.KR,
-				Romaji:      s.Romaji,
-				Level:       s.Level,
-				SubCategory: s.SubCategory,
-				Words:       words,
-				Grammar:     s.Grammar,
-				Examples:    s.Examples,
-				Quiz:        quiz,
-				Memorized:   s.Memorized,
-			}
+			sentences[j] = convertSentence(s)
 		}
 
 		history[i] = HistoryItemResponse{
@@ -209,3 +185,71 @@ func convertHistoryToResponse(result *sentence.HistorySentencesResponse) *Histor
 		TotalPages: result.TotalPages,
 	}
 }
+
+func convertSentence(s sentence.SentenceWithDetail) SentenceResponse {
+	// Words 변환
+	words := make([]WordResponse, len(s.Words))
+	for i, w := range s.Words {
+		words[i] = WordResponse{
+			Japanese: w.Japanese,
+			Reading:  w.Reading,
+			Meaning:  w.Meaning,
+			PartOf:   w.PartOf,
+		}
+	}
+
+	// Quiz 변환
+	var quiz *QuizResponse
+	if s.Quiz != nil {
+		quiz = &QuizResponse{}
+		if s.Quiz.FillBlank != nil {
+			quiz.FillBlank = &QuizFillBlankResponse{
+				QuestionJP: s.Quiz.FillBlank.QuestionJP,
+				Options:    s.Quiz.FillBlank.Options,
+			}
+		}
+		if s.Quiz.Ordering != nil {
+			quiz.Ordering = &QuizOrderingResponse{
+				Fragments: s.Quiz.Ordering.Fragments,
+			}
+		}
+		if s.Quiz.Meaning != nil {
+			quiz.Meaning = &QuizChoiceResponse{
+				Options: s.Quiz.Meaning.Options,
+			}
+		}
+		if s.Quiz.Listening != nil {
+			quiz.Listening = &QuizListeningResponse{
+				AudioURL: s.Quiz.Listening.AudioURL,
+				Options:  s.Quiz.Listening.Options,
+			}
+		}
+		if s.Quiz.Dictation != nil {
+			quiz.Dictation = &QuizDictationResponse{
+				AudioURL: s.Quiz.Dictation.AudioURL,
+			}
+		}
+		if s.Quiz.Reading != nil {
+			quiz.Reading = &QuizReadingResponse{
+				Words: s.Quiz.Reading.Words,
+			}
+		}
+	}
+
+	return SentenceResponse{
+		ID:          s.ID,
+		SentenceKey: s.SentenceKey,
+		JP:          s.JP,
+		KR:          s.KR,
+		Romaji:      s.Romaji,
+		Level:       s.Level,
+		SubCategory: s.SubCategory,
+		Words:       words,
+		Grammar:     s.Grammar,
+		Examples:    s.Examples,
+		Quiz:        quiz,
+		Memorized:   s.Memorized,
+		Review:      s.Review,
+		Bookmarked:  s.Bookmarked,
+	}
+}
@@ -1,13 +1,16 @@
 package user
 
+import "time"
+
 type UpdateProfileRequest struct {
 	Name string `json:"name"`
 }
 
 type OnboardingRequest struct {
-	Level     int   `json:"level" binding:"min=0,max=5"`
-	Interests []int `json:"interests"` // pkg.SubCategory 값들
-	Purposes  []int `json:"purposes"`  // pkg.Purpose 값들
+	Level     int    `json:"level" binding:"min=0,max=3"`
+	Interests []int  `json:"interests"` // pkg.SubCategory 값들
+	Purposes  []int  `json:"purposes"`  // pkg.Purpose 값들
+	Timezone  string `json:"timezone"`  // IANA 타임존 (예: "Asia/Seoul"), 비우면 유지
 }
 
 type UpdateSettingsRequest struct {
@@ -16,4 +19,10 @@ type UpdateSettingsRequest struct {
 	PreferredVoiceSpeed *float64 `json:"preferred_voice_speed,omitempty"`
 	ShowRomaji          *bool    `json:"show_romaji,omitempty"`
 	ShowTranslation     *bool    `json:"show_translation,omitempty"`
+	Timezone            *string  `json:"timezone,omitempty"` // IANA 타임존 (예: "Asia/Seoul")
+}
+
+// DeleteAccountResponse 탈퇴 요청 결과
+type DeleteAccountResponse struct {
+	DeletionScheduledAt time.Time `json:"deletion_scheduled_at"` // 이 시각 이후 영구 삭제 (그 전에 로그인하면 취소)
 }
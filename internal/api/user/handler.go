@@ -1,6 +1,11 @@
 package user
 
 import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+
 	"github.com/gin-gonic/gin"
 	"github.com/jptaku/server/internal/middleware"
 	"github.com/jptaku/server/internal/pkg"
@@ -24,6 +29,8 @@ func (h *Handler) RegisterRoutes(r *gin.RouterGroup, authMiddleware gin.HandlerF
 	user.Use(authMiddleware)
 	{
 		user.GET("/me", h.GetMe)
+		user.DELETE("/me", h.DeleteAccount)
+		user.GET("/me/export", h.ExportData)
 		user.PUT("/profile", h.UpdateProfile)
 		user.POST("/onboarding", h.SaveOnboarding)
 		user.GET("/settings", h.GetSettings)
@@ -118,10 +125,19 @@ func (h *Handler) SaveOnboarding(c *gin.Context) {
 		Level:     req.Level,
 		Interests: req.Interests,
 		Purposes:  req.Purposes,
+		Timezone:  req.Timezone,
 	}
 
 	onboarding, err := h.userService.SaveOnboarding(userID, input)
 	if err != nil {
+		if errors.Is(err, pkg.ErrUnknownTaxonomyCode) {
+			pkg.BadRequestResponse(c, "알 수 없는 레벨, 관심사 또는 목적 코드입니다 (/api/meta 참고)")
+			return
+		}
+		if errors.Is(err, pkg.ErrBadRequest) {
+			pkg.BadRequestResponse(c, "올바르지 않은 타임존입니다")
+			return
+		}
 		pkg.InternalServerErrorResponse(c, "온보딩 정보 저장 실패")
 		return
 	}
@@ -182,13 +198,89 @@ func (h *Handler) UpdateSettings(c *gin.Context) {
 		PreferredVoiceSpeed: req.PreferredVoiceSpeed,
 		ShowRomaji:          req.ShowRomaji,
 		ShowTranslation:     req.ShowTranslation,
+		Timezone:            req.Timezone,
 	}
 
 	settings, err := h.userService.UpdateSettings(userID, input)
 	if err != nil {
+		if errors.Is(err, pkg.ErrBadRequest) {
+			pkg.BadRequestResponse(c, "올바르지 않은 타임존입니다")
+			return
+		}
 		pkg.InternalServerErrorResponse(c, "설정 수정 실패")
 		return
 	}
 
 	pkg.SuccessResponse(c, settings)
 }
+
+// DeleteAccount godoc
+// @Summary 회원 탈퇴
+// @Description 탈퇴를 요청하고 모든 기기에서 로그아웃합니다. 유예 기간이 지나면 모든 데이터가 영구 삭제되며, 그 전에 다시 로그인하면 탈퇴가 취소됩니다.
+// @Tags User
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {object} DeleteAccountResponse
+// @Router /api/user/me [delete]
+func (h *Handler) DeleteAccount(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		pkg.UnauthorizedResponse(c, "")
+		return
+	}
+
+	scheduledAt, err := h.userService.DeleteAccount(c.Request.Context(), userID)
+	if err != nil {
+		pkg.InternalServerErrorResponse(c, "탈퇴 처리에 실패했습니다")
+		return
+	}
+
+	pkg.SuccessResponse(c, DeleteAccountResponse{DeletionScheduledAt: scheduledAt})
+}
+
+// ExportData godoc
+// @Summary 내 데이터 내보내기
+// @Description 계정, 로그인 수단, 기기, 학습 기록, 대화, 피드백 등 모든 데이터를 파일로 내려받습니다.
+// @Tags User
+// @Security BearerAuth
+// @Produce application/zip
+// @Produce json
+// @Param format query string false "zip 또는 json" default(zip)
+// @Success 200 {file} file
+// @Router /api/user/me/export [get]
+func (h *Handler) ExportData(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		pkg.UnauthorizedResponse(c, "")
+		return
+	}
+
+	format := c.DefaultQuery("format", "zip")
+	if format != "zip" && format != "json" {
+		pkg.BadRequestResponse(c, "format은 zip 또는 json이어야 합니다")
+		return
+	}
+
+	export, err := h.userService.ExportData(userID)
+	if err != nil {
+		pkg.InternalServerErrorResponse(c, "데이터 내보내기에 실패했습니다")
+		return
+	}
+
+	filename := fmt.Sprintf("jptaku-export-%d-%s", userID, export.ExportedAt.Format("20060102"))
+
+	if format == "json" {
+		c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s.json"`, filename))
+		c.IndentedJSON(http.StatusOK, export)
+		return
+	}
+
+	var buf bytes.Buffer
+	if err := export.WriteZip(&buf); err != nil {
+		pkg.InternalServerErrorResponse(c, "데이터 내보내기에 실패했습니다")
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s.zip"`, filename))
+	c.Data(http.StatusOK, "application/zip", buf.Bytes())
+}
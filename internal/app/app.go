@@ -8,17 +8,22 @@ import (
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/jptaku/server/internal/cache"
 	"github.com/jptaku/server/internal/config"
+	"github.com/redis/go-redis/v9"
+	"github.com/robfig/cron/v3"
 	"gorm.io/gorm"
 )
 
 // App 애플리케이션 구조체
 type App struct {
-	cfg    *config.Config
-	db     *gorm.DB
-	deps   *Dependencies
-	router *gin.Engine
-	server *http.Server
+	cfg       *config.Config
+	db        *gorm.DB
+	redis     *redis.Client
+	deps      *Dependencies
+	router    *gin.Engine
+	server    *http.Server
+	scheduler *cron.Cron
 }
 
 // New 애플리케이션 생성
@@ -29,12 +34,29 @@ func New(cfg *config.Config) (*App, error) {
 		return nil, fmt.Errorf("failed to initialize database: %w", err)
 	}
 
+	// Redis (release 모드에서는 필수, debug/test 모드에서만 in-memory fallback 사용)
+	// 토큰/OAuth state/로그인 코드 저장소가 인스턴스별 메모리로 갈라지지 않도록 운영에서는 기동을 중단합니다.
+	rdb, err := cache.NewRedisClient(&cfg.Redis)
+	if err != nil {
+		if cfg.Server.Mode == gin.ReleaseMode {
+			return nil, fmt.Errorf("failed to initialize redis: %w", err)
+		}
+		log.Printf("Warning: Redis unavailable, falling back to in-memory stores (%s mode only): %v", cfg.Server.Mode, err)
+		rdb = nil
+	}
+
 	// Dependencies (repos, services, infra)
-	deps := NewDependencies(db, cfg)
+	deps := NewDependencies(db, rdb, cfg)
 
 	// Router
 	router := NewRouter(deps, cfg)
 
+	// Background jobs
+	scheduler, err := NewScheduler(deps, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize scheduler: %w", err)
+	}
+
 	// HTTP Server
 	addr := fmt.Sprintf(":%s", cfg.Server.Port)
 	server := &http.Server{
@@ -46,16 +68,20 @@ func New(cfg *config.Config) (*App, error) {
 	}
 
 	return &App{
-		cfg:    cfg,
-		db:     db,
-		deps:   deps,
-		router: router,
-		server: server,
+		cfg:       cfg,
+		db:        db,
+		redis:     rdb,
+		deps:      deps,
+		router:    router,
+		server:    server,
+		scheduler: scheduler,
 	}, nil
 }
 
 // Run 서버 시작
 func (a *App) Run() error {
+	a.scheduler.Start()
+
 	log.Printf("Server is starting on %s", a.server.Addr)
 	if err := a.server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
 		return fmt.Errorf("failed to start server: %w", err)
@@ -67,9 +93,20 @@ func (a *App) Run() error {
 func (a *App) Shutdown(ctx context.Context) error {
 	log.Println("Shutting down server...")
 
+	// Background jobs 종료 (실행 중인 작업 완료 대기)
+	select {
+	case <-a.scheduler.Stop().Done():
+	case <-ctx.Done():
+	}
+
 	// Async service 종료
 	a.deps.Services.Async.Stop()
 
+	// Redis 연결 종료
+	if a.redis != nil {
+		a.redis.Close()
+	}
+
 	// DB 연결 종료
 	if sqlDB, err := a.db.DB(); err == nil && sqlDB != nil {
 		sqlDB.Close()
@@ -2,9 +2,11 @@ package app
 
 import (
 	"log"
+	"strings"
 
 	"github.com/jptaku/server/internal/config"
 	"github.com/jptaku/server/internal/model"
+	"github.com/jptaku/server/internal/pkg"
 	"gorm.io/gorm"
 )
 
@@ -19,19 +21,33 @@ func NewDatabase(cfg *config.Config) (*gorm.DB, error) {
 		return nil, err
 	}
 
+	if err := bootstrapAdmins(db, cfg.Account.AdminEmails); err != nil {
+		return nil, err
+	}
+
 	return db, nil
 }
 
 // RunMigrations 데이터베이스 마이그레이션 실행
 func RunMigrations(db *gorm.DB) error {
+	if err := dedupeDailySets(db); err != nil {
+		return err
+	}
+
 	if err := db.AutoMigrate(
 		&model.User{},
+		&model.UserIdentity{},
+		&model.UserSession{},
 		&model.UserSettings{},
 		&model.UserOnboarding{},
 		&model.Sentence{},
 		&model.SentenceDetail{},
 		&model.DailySentenceSet{},
 		&model.LearningProgress{},
+		&model.SentenceReview{},
+		&model.QuizAttempt{},
+		&model.BookmarkFolder{},
+		&model.SentenceBookmark{},
 		&model.ChatSession{},
 		&model.ChatMessage{},
 		&model.Feedback{},
@@ -39,6 +55,255 @@ func RunMigrations(db *gorm.DB) error {
 		return err
 	}
 
+	if err := backfillUserIdentities(db); err != nil {
+		return err
+	}
+
+	if err := setupSentenceSearch(db); err != nil {
+		return err
+	}
+
+	if err := backfillQuizTypes(db); err != nil {
+		return err
+	}
+
+	if err := backfillQuizAttemptComplete(db); err != nil {
+		return err
+	}
+
 	log.Println("Database migration completed")
 	return nil
 }
+
+// backfillUserIdentities user_identities 도입 전 가입자의 provider 정보를 identity로 복사
+// 이미 존재하는 identity는 건너뛰므로 매 기동 시 실행해도 안전합니다.
+func backfillUserIdentities(db *gorm.DB) error {
+	return db.Exec(`
+		INSERT INTO user_identities (user_id, provider, provider_id, email, email_verified, created_at, updated_at)
+		SELECT u.id, u.provider, u.provider_id, u.email, u.provider = 'google', u.created_at, NOW()
+		FROM users u
+		WHERE u.deleted_at IS NULL AND u.provider <> '' AND u.provider_id <> ''
+		ON CONFLICT DO NOTHING
+	`).Error
+}
+
+// setupSentenceSearch 문장 검색용 pg_trgm 인덱스 생성 및 search_text 채우기
+// pg_trgm 확장을 만들 권한이 없으면 경고만 남기고, 검색은 부분 일치(LIKE)로만 동작합니다.
+func setupSentenceSearch(db *gorm.DB) error {
+	if err := db.Exec(`CREATE EXTENSION IF NOT EXISTS pg_trgm`).Error; err != nil {
+		log.Printf("Warning: pg_trgm unavailable, sentence search falls back to LIKE: %v", err)
+	} else if err := db.Exec(`
+		CREATE INDEX IF NOT EXISTS idx_sentences_search_text_trgm ON sentences USING gin (search_text gin_trgm_ops)
+	`).Error; err != nil {
+		return err
+	}
+
+	return backfillSentenceSearchText(db)
+}
+
+// backfillSentenceSearchText search_text 도입 전에 만들어진 문장의 검색용 텍스트 생성
+func backfillSentenceSearchText(db *gorm.DB) error {
+	const batchSize = 500
+
+	var lastID uint
+	filled := 0
+	for {
+		var sentences []model.Sentence
+		if err := db.Where("id > ? AND search_text = ''", lastID).Order("id").Limit(batchSize).Find(&sentences).Error; err != nil {
+			return err
+		}
+		if len(sentences) == 0 {
+			break
+		}
+
+		ids := make([]uint, len(sentences))
+		for i, sentence := range sentences {
+			ids[i] = sentence.ID
+		}
+		var details []model.SentenceDetail
+		if err := db.Where("sentence_id IN ?", ids).Find(&details).Error; err != nil {
+			return err
+		}
+		words := make(map[uint][]model.Word, len(details))
+		for _, detail := range details {
+			words[detail.SentenceID] = detail.Words
+		}
+
+		for i := range sentences {
+			sentences[i].SetSearchText(words[sentences[i].ID])
+			if err := db.Model(&sentences[i]).UpdateColumn("search_text", sentences[i].SearchText).Error; err != nil {
+				return err
+			}
+		}
+		filled += len(sentences)
+		lastID = ids[len(ids)-1]
+	}
+
+	if filled > 0 {
+		log.Printf("Filled search text of %d sentences", filled)
+	}
+	return nil
+}
+
+// backfillQuizTypes 뜻 고르기/듣기/받아쓰기/단어 읽기 도입 전 문장(또는 나중에 음성이 생긴 문장)의 퀴즈 채우기
+// 오답 보기는 같은 레벨의 무작위 문장에서 고르며, 채울 수 있는 유형이 없는 문장은 그대로 둡니다.
+func backfillQuizTypes(db *gorm.DB) error {
+	const (
+		batchSize = 500
+		poolSize  = 200
+	)
+
+	pools := make(map[int][]model.Sentence)
+	var lastID uint
+	filled := 0
+	for {
+		var sentences []model.Sentence
+		if err := db.Table("sentences s").
+			Select("s.*").
+			Joins("JOIN sentence_details d ON d.sentence_id = s.id").
+			Where("s.id > ? AND s.deleted_at IS NULL", lastID).
+			Where("d.quiz IS NULL OR d.quiz->'meaning' IS NULL OR (s.audio_url <> '' AND d.quiz->'dictation' IS NULL)").
+			Order("s.id").
+			Limit(batchSize).
+			Find(&sentences).Error; err != nil {
+			return err
+		}
+		if len(sentences) == 0 {
+			break
+		}
+
+		ids := make([]uint, len(sentences))
+		for i, sentence := range sentences {
+			ids[i] = sentence.ID
+		}
+		var details []model.SentenceDetail
+		if err := db.Where("sentence_id IN ?", ids).Find(&details).Error; err != nil {
+			return err
+		}
+		byID := make(map[uint]*model.SentenceDetail, len(details))
+		for i := range details {
+			byID[details[i].SentenceID] = &details[i]
+		}
+
+		for i := range sentences {
+			sentence := &sentences[i]
+			pool, ok := pools[sentence.Level]
+			if !ok {
+				if err := db.Where("level = ?", sentence.Level).Order("RANDOM()").Limit(poolSize).Find(&pool).Error; err != nil {
+					return err
+				}
+				pools[sentence.Level] = pool
+			}
+
+			detail := byID[sentence.ID]
+			if detail == nil || !detail.CompleteQuiz(sentence, pool) {
+				continue
+			}
+			if err := db.Model(detail).Select("quiz").Updates(detail).Error; err != nil {
+				return err
+			}
+			filled++
+		}
+		lastID = ids[len(ids)-1]
+	}
+
+	if filled > 0 {
+		log.Printf("Filled new quiz types of %d sentences", filled)
+	}
+	return nil
+}
+
+// backfillQuizAttemptComplete 유형별 기록(parts) 도입 전 시도는 빈칸 채우기/문장 배열을 항상 함께 채점했으므로 완료로 표시
+func backfillQuizAttemptComplete(db *gorm.DB) error {
+	return db.Exec(`UPDATE quiz_attempts SET complete = TRUE WHERE (parts IS NULL OR jsonb_typeof(parts) <> 'array') AND NOT complete`).Error
+}
+
+// dedupeDailySets (user_id, date) 유니크 인덱스 도입 전 중복 생성된 데일리 세트 정리
+// 같은 날짜의 세트 중 학습 기록이 가장 많은 것(같으면 먼저 만들어진 것)을 남기고,
+// 지워지는 세트를 가리키던 학습 기록/퀴즈 시도/대화 세션은 남는 세트로 옮깁니다.
+func dedupeDailySets(db *gorm.DB) error {
+	if !db.Migrator().HasTable(&model.DailySentenceSet{}) {
+		return nil
+	}
+
+	progressCount := "0"
+	if db.Migrator().HasTable(&model.LearningProgress{}) {
+		progressCount = "(SELECT COUNT(*) FROM learning_progress lp WHERE lp.daily_set_id = s.id)"
+	}
+
+	type duplicate struct {
+		ID     uint
+		KeepID uint
+	}
+	var duplicates []duplicate
+	if err := db.Raw(`
+		SELECT id, keep_id FROM (
+			SELECT s.id, FIRST_VALUE(s.id) OVER (
+				PARTITION BY s.user_id, s.date ORDER BY ` + progressCount + ` DESC, s.id
+			) AS keep_id
+			FROM daily_sentence_sets s
+			WHERE (s.user_id, s.date) IN (
+				SELECT user_id, date FROM daily_sentence_sets GROUP BY user_id, date HAVING COUNT(*) > 1
+			)
+		) d
+		WHERE id <> keep_id
+	`).Scan(&duplicates).Error; err != nil {
+		return err
+	}
+	if len(duplicates) == 0 {
+		return nil
+	}
+
+	referencing := []interface{}{&model.LearningProgress{}, &model.QuizAttempt{}, &model.ChatSession{}}
+	err := db.Transaction(func(tx *gorm.DB) error {
+		for _, d := range duplicates {
+			for _, m := range referencing {
+				if !tx.Migrator().HasTable(m) {
+					continue
+				}
+				if err := tx.Model(m).Where("daily_set_id = ?", d.ID).Update("daily_set_id", d.KeepID).Error; err != nil {
+					return err
+				}
+			}
+			if err := tx.Delete(&model.DailySentenceSet{}, d.ID).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Printf("Removed %d duplicate daily sentence sets", len(duplicates))
+	return nil
+}
+
+// bootstrapAdmins ADMIN_EMAILS에 있는 사용자에게 admin 역할 부여
+// 남의 이메일로 가입한 계정이 권한을 얻지 않도록, 공급자나 메일 인증으로 확인된 이메일만 대상입니다.
+func bootstrapAdmins(db *gorm.DB, emails []string) error {
+	if len(emails) == 0 {
+		return nil
+	}
+
+	lowered := make([]string, 0, len(emails))
+	for _, email := range emails {
+		lowered = append(lowered, strings.ToLower(email))
+	}
+
+	result := db.Exec(`
+		UPDATE users SET role = ?, updated_at = NOW()
+		WHERE LOWER(email) IN ? AND role <> ? AND deleted_at IS NULL
+		AND EXISTS (
+			SELECT 1 FROM user_identities i
+			WHERE i.user_id = users.id AND i.email_verified AND LOWER(i.email) = LOWER(users.email)
+		)
+	`, pkg.RoleAdmin, lowered, pkg.RoleAdmin)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected > 0 {
+		log.Printf("Granted admin role to %d users", result.RowsAffected)
+	}
+	return nil
+}
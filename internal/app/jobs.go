@@ -0,0 +1,90 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/jptaku/server/internal/cache"
+	"github.com/jptaku/server/internal/config"
+	"github.com/robfig/cron/v3"
+)
+
+// NewScheduler API 서버에서 주기적으로 실행할 백그라운드 작업 등록
+func NewScheduler(deps *Dependencies, cfg *config.Config) (*cron.Cron, error) {
+	c := cron.New()
+
+	// 탈퇴 유예 기간이 지난 계정 영구 삭제 (매시 정각)
+	if _, err := c.AddFunc("0 * * * *", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+		defer cancel()
+
+		purged, err := deps.Services.User.PurgeDeletedAccounts(ctx)
+		if err != nil {
+			log.Printf("Account purge failed: %v", err)
+		}
+		if purged > 0 {
+			log.Printf("Purged %d deleted accounts", purged)
+		}
+	}); err != nil {
+		return nil, err
+	}
+
+	// 방치된 게스트 계정 정리 (매일 04:30)
+	if _, err := c.AddFunc("30 4 * * *", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+		defer cancel()
+
+		purged, err := deps.Services.User.PurgeAbandonedGuests(ctx)
+		if err != nil {
+			log.Printf("Guest purge failed: %v", err)
+		}
+		if purged > 0 {
+			log.Printf("Purged %d abandoned guest accounts", purged)
+		}
+	}); err != nil {
+		return nil, err
+	}
+
+	// 활동 중인 사용자의 내일 문장 세트 미리 생성 (기본 타임존 기준 매일 23:00)
+	if activeDays := cfg.DailySet.PregenerateActiveDays; activeDays > 0 {
+		spec := fmt.Sprintf("CRON_TZ=%s 0 23 * * *", cfg.Account.DefaultTimezone)
+		if _, err := c.AddFunc(spec, func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Minute)
+			defer cancel()
+
+			if !acquireJobLock(ctx, deps, "daily-set-pregenerate", time.Hour) {
+				return
+			}
+
+			created, err := deps.Services.Sentence.PregenerateDailySets(ctx, time.Now().AddDate(0, 0, -activeDays))
+			if err != nil {
+				log.Printf("Daily set pregeneration failed: %v", err)
+			}
+			log.Printf("Pregenerated %d daily sentence sets", created)
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	return c, nil
+}
+
+// acquireJobLock 여러 인스턴스가 같은 예약 작업을 동시에 실행하지 않도록 잠금
+// Redis가 없으면(단일 인스턴스 개발 환경) 항상 실행하고, 잠금을 확인할 수 없으면 이번 실행을 건너뜁니다.
+func acquireJobLock(ctx context.Context, deps *Dependencies, name string, ttl time.Duration) bool {
+	if deps.Infra.Redis == nil {
+		return true
+	}
+
+	acquired, err := cache.NewJobLock(deps.Infra.Redis).TryAcquire(ctx, name, ttl)
+	if err != nil {
+		log.Printf("Job lock check failed, skipping %s: %v", name, err)
+		return false
+	}
+	if !acquired {
+		log.Printf("Job %s is running on another instance, skipping", name)
+	}
+	return acquired
+}
@@ -1,17 +1,24 @@
 package app
 
 import (
+	"time"
+
 	"github.com/gin-gonic/gin"
 	_ "github.com/jptaku/server/docs" // Swagger docs
+	"github.com/jptaku/server/internal/api/admin"
 	"github.com/jptaku/server/internal/api/audio"
 	"github.com/jptaku/server/internal/api/auth"
+	"github.com/jptaku/server/internal/api/bookmark"
 	"github.com/jptaku/server/internal/api/chat"
 	"github.com/jptaku/server/internal/api/feedback"
 	"github.com/jptaku/server/internal/api/learning"
+	"github.com/jptaku/server/internal/api/meta"
+	"github.com/jptaku/server/internal/api/review"
 	"github.com/jptaku/server/internal/api/sentences"
 	"github.com/jptaku/server/internal/api/user"
 	"github.com/jptaku/server/internal/config"
 	"github.com/jptaku/server/internal/middleware"
+	"github.com/jptaku/server/internal/pkg"
 	swaggerFiles "github.com/swaggo/files"
 	ginSwagger "github.com/swaggo/gin-swagger"
 )
@@ -39,21 +46,40 @@ func NewRouter(deps *Dependencies, cfg *config.Config) *gin.Engine {
 	chatHandler := chat.NewHandler(deps.Services.Chat)
 	feedbackHandler := feedback.NewHandler(deps.Services.Feedback)
 	audioHandler := audio.NewHandler(deps.Infra.S3Client, deps.Infra.BucketName)
+	adminHandler := admin.NewHandler(deps.Services.Admin)
+	reviewHandler := review.NewHandler(deps.Services.Review)
+	bookmarkHandler := bookmark.NewHandler(deps.Services.Bookmark)
+	metaHandler := meta.NewHandler()
 
 	// API routes
 	api := r.Group("/api")
 	{
-		// Public routes
-		authHandler.RegisterRoutes(api)
-		audioHandler.RegisterRoutes(api)
+		authMiddleware := middleware.AuthMiddleware(deps.Infra.JWTManager)
+		rateLimited := func(name string, perMinute int) *gin.RouterGroup {
+			policy := pkg.RateLimitPolicy{Name: name, Limit: perMinute, Window: time.Minute}
+			return api.Group("", middleware.RateLimit(deps.Infra.RateLimiter, policy, deps.Infra.JWTManager))
+		}
+
+		// Public routes (auth는 일부 경로만 인증 필요)
+		authHandler.RegisterRoutes(rateLimited(pkg.RateLimitAuth, cfg.RateLimit.AuthPerMinute), authMiddleware)
+		audioHandler.RegisterRoutes(rateLimited(pkg.RateLimitAudio, cfg.RateLimit.AudioPerMinute))
+		metaHandler.RegisterRoutes(api)
 
 		// Protected routes
-		authMiddleware := middleware.AuthMiddleware(deps.Infra.JWTManager)
 		userHandler.RegisterRoutes(api, authMiddleware)
-		sentencesHandler.RegisterRoutes(api, authMiddleware)
+		sentencesGroup := rateLimited(pkg.RateLimitSentences, cfg.RateLimit.SentencesPerMinute)
+		sentencesHandler.RegisterRoutes(sentencesGroup, authMiddleware)
+		bookmarkHandler.RegisterRoutes(sentencesGroup, authMiddleware)
 		learningHandler.RegisterRoutes(api, authMiddleware)
-		chatHandler.RegisterRoutes(api, authMiddleware)
+		chatHandler.RegisterRoutes(rateLimited(pkg.RateLimitChat, cfg.RateLimit.ChatPerMinute), authMiddleware)
 		feedbackHandler.RegisterRoutes(api, authMiddleware)
+		reviewHandler.RegisterRoutes(api, authMiddleware)
+
+		// Admin routes (운영/관리자 역할만, 세부 권한은 라우트별 확인)
+		// 토큰의 role 클레임 대신 DB의 현재 역할로 확인해 강등이 바로 반영되게 합니다.
+		adminGroup := api.Group("/admin", authMiddleware, middleware.CurrentRole(deps.Infra.RoleCache),
+			middleware.RequireRole(pkg.RoleOperator, pkg.RoleAdmin))
+		adminHandler.RegisterRoutes(adminGroup)
 	}
 
 	return r
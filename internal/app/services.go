@@ -2,31 +2,45 @@ package app
 
 import (
 	"log"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/credentials"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/jptaku/server/internal/cache"
 	"github.com/jptaku/server/internal/config"
+	"github.com/jptaku/server/internal/middleware"
 	"github.com/jptaku/server/internal/pkg"
 	"github.com/jptaku/server/internal/repository"
 	"github.com/jptaku/server/internal/service"
+	adminSvc "github.com/jptaku/server/internal/service/admin"
 	authSvc "github.com/jptaku/server/internal/service/auth"
+	bookmarkSvc "github.com/jptaku/server/internal/service/bookmark"
 	chatSvc "github.com/jptaku/server/internal/service/chat"
 	feedbackSvc "github.com/jptaku/server/internal/service/feedback"
 	learningSvc "github.com/jptaku/server/internal/service/learning"
+	reviewSvc "github.com/jptaku/server/internal/service/review"
 	"github.com/jptaku/server/internal/service/sentence"
 	userSvc "github.com/jptaku/server/internal/service/user"
+	"github.com/redis/go-redis/v9"
 	"gorm.io/gorm"
 )
 
+// roleCacheTTL 관리 라우트 역할 캐시 유지 시간 (다른 인스턴스의 역할 변경은 이 시간 안에 반영)
+const roleCacheTTL = 30 * time.Second
+
 // Repositories 모든 저장소
 type Repositories struct {
-	DBManager    *repository.DBManager
-	User         *repository.UserRepository
-	Sentence     *repository.SentenceRepository
-	Learning     *repository.LearningRepository
-	Chat         *repository.ChatRepository
-	Feedback     *repository.FeedbackRepository
+	DBManager *repository.DBManager
+	User      *repository.UserRepository
+	Session   *repository.SessionRepository
+	Sentence  *repository.CachedSentenceRepository
+	Learning  *repository.LearningRepository
+	Chat      *repository.ChatRepository
+	Feedback  *repository.FeedbackRepository
+	Review    *repository.ReviewRepository
+	Bookmark  *repository.BookmarkRepository
+	Quiz      *repository.QuizAttemptRepository
 }
 
 // Services 모든 서비스
@@ -37,14 +51,20 @@ type Services struct {
 	Learning learningSvc.Provider
 	Chat     chatSvc.Provider
 	Feedback feedbackSvc.Provider
+	Admin    adminSvc.Provider
+	Review   reviewSvc.Provider
+	Bookmark bookmarkSvc.Provider
 	Async    *service.AsyncService
 }
 
 // Infra 인프라 의존성
 type Infra struct {
-	JWTManager *pkg.JWTManager
-	S3Client   *s3.Client
-	BucketName string
+	JWTManager  *pkg.JWTManager
+	Redis       *redis.Client // nil이면 Redis 미사용
+	RateLimiter middleware.RateLimiter
+	RoleCache   *cache.RoleCache
+	S3Client    *s3.Client
+	BucketName  string
 }
 
 // Dependencies 모든 의존성
@@ -55,19 +75,30 @@ type Dependencies struct {
 }
 
 // NewDependencies 모든 의존성 초기화
-func NewDependencies(db *gorm.DB, cfg *config.Config) *Dependencies {
+func NewDependencies(db *gorm.DB, rdb *redis.Client, cfg *config.Config) *Dependencies {
+	// 읽기 캐시 (Redis가 없으면 항상 DB 조회)
+	readThrough := cache.NewReadThrough(rdb)
+	sentenceCacheTTL := time.Duration(cfg.Cache.SentenceTTLMinutes) * time.Minute
+
 	// Repositories
 	repos := &Repositories{
 		DBManager: repository.NewDBManager(db),
 		User:      repository.NewUserRepository(db),
-		Sentence:  repository.NewSentenceRepository(db),
+		Session:   repository.NewSessionRepository(db),
+		Sentence:  repository.NewCachedSentenceRepository(repository.NewSentenceRepository(db), readThrough, sentenceCacheTTL),
 		Learning:  repository.NewLearningRepository(db),
 		Chat:      repository.NewChatRepository(db),
 		Feedback:  repository.NewFeedbackRepository(db),
+		Review:    repository.NewReviewRepository(db),
+		Bookmark:  repository.NewBookmarkRepository(db),
+		Quiz:      repository.NewQuizAttemptRepository(db),
 	}
 
 	// Infrastructure
-	jwtManager := pkg.NewJWTManager(cfg.JWT.Secret, cfg.JWT.ExpirationHours)
+	jwtManager := pkg.NewJWTManager(cfg.JWT.KeyID, cfg.JWT.Secret, cfg.JWT.ExpirationHours)
+	for kid, secret := range cfg.JWT.PreviousKeys {
+		jwtManager.AddVerificationKey(kid, secret)
+	}
 
 	s3Client := s3.New(s3.Options{
 		Region:       "kr-standard",
@@ -77,31 +108,67 @@ func NewDependencies(db *gorm.DB, cfg *config.Config) *Dependencies {
 
 	infra := &Infra{
 		JWTManager: jwtManager,
+		Redis:      rdb,
+		RoleCache:  cache.NewRoleCache(repos.User, roleCacheTTL),
 		S3Client:   s3Client,
 		BucketName: cfg.NCP_Storage.BucketName,
 	}
+	if rdb != nil {
+		infra.RateLimiter = cache.NewRateLimiter(rdb)
+	} else {
+		infra.RateLimiter = cache.NewMemoryRateLimiter()
+	}
 
 	// Services
 	asyncService := service.NewAsyncService(4, 100)
 
-	authService := authSvc.NewService(repos.DBManager, repos.User, jwtManager)
-	if cfg.Google.ClientID != "" && cfg.Google.ClientSecret != "" {
-		googleOAuth := pkg.NewGoogleOAuthManager(
-			cfg.Google.ClientID,
-			cfg.Google.ClientSecret,
-			cfg.Google.RedirectURL,
-		)
-		authService.SetGoogleOAuth(googleOAuth)
-		log.Println("Google OAuth initialized")
+	var tokenStore authSvc.RefreshTokenStore
+	if rdb != nil {
+		tokenStore = cache.NewRefreshTokenStore(rdb)
 	} else {
-		log.Println("Warning: Google OAuth not configured")
+		tokenStore = cache.NewMemoryRefreshTokenStore()
 	}
 
-	sentenceService := sentence.NewService(repos.Sentence, repos.User)
-	userService := userSvc.NewService(repos.User, sentenceService)
-	learningService := learningSvc.NewService(repos.Learning, repos.Sentence)
+	authService := authSvc.NewService(repos.DBManager, repos.User, repos.Session, jwtManager, tokenStore)
+
+	stateSecret := cfg.OAuth.StateSecret
+	if stateSecret == "" {
+		stateSecret = cfg.JWT.Secret
+	}
+	stateManager := pkg.NewOAuthStateManager(stateSecret, cfg.OAuth.AllowedRedirects, cfg.OAuth.MobileRedirect)
+	if rdb != nil {
+		authService.SetOAuthState(stateManager, cache.NewOAuthStateStore(rdb), cache.NewLoginCodeStore(rdb))
+		authService.SetMergeTicketStore(cache.NewMergeTicketStore(rdb))
+		authService.SetEmailAuth(cache.NewEmailTokenStore(rdb), newMailer(cfg), asyncService, emailLinks(cfg))
+	} else {
+		authService.SetOAuthState(stateManager, cache.NewMemoryOAuthStateStore(), cache.NewMemoryLoginCodeStore())
+		authService.SetMergeTicketStore(cache.NewMemoryMergeTicketStore())
+		authService.SetEmailAuth(cache.NewMemoryEmailTokenStore(), newMailer(cfg), asyncService, emailLinks(cfg))
+	}
+	authService.SetOAuthProviders(newOAuthRegistry(cfg))
+
+	calendar := pkg.NewCalendar(pkg.SystemClock, cfg.Account.DefaultTimezone)
+
+	reviewService := reviewSvc.NewService(repos.Review, repos.User, calendar)
+	sentenceService := sentence.NewService(repos.Sentence, repos.User, calendar)
+	sentenceService.SetReviewRepo(repos.Review, cfg.Review.PerDailySet)
+	sentenceService.SetLearningRepo(repos.Learning)
+	sentenceService.SetBookmarkRepo(repos.Bookmark)
+	if cfg.Cache.TodayTTLMinutes > 0 {
+		sentenceService.SetTodayCache(readThrough, time.Duration(cfg.Cache.TodayTTLMinutes)*time.Minute)
+	}
+	userService := userSvc.NewService(repos.User, sentenceService, authService,
+		time.Duration(cfg.Account.DeletionGraceDays)*24*time.Hour,
+		time.Duration(cfg.Account.GuestTTLDays)*24*time.Hour)
+	learningService := learningSvc.NewService(repos.Learning, repos.Sentence, repos.User, repos.Quiz, reviewService)
+	learningService.SetTodayInvalidator(sentenceService)
 	chatService := chatSvc.NewService(repos.Chat, repos.Sentence)
-	feedbackService := feedbackSvc.NewService(repos.Feedback, repos.Chat)
+	feedbackService := feedbackSvc.NewService(repos.Feedback, repos.Chat, repos.Learning, repos.User, calendar)
+	adminService := adminSvc.NewService(repos.User, repos.Quiz)
+	adminService.SetSessionRevoker(authService)
+	adminService.SetRoleInvalidator(infra.RoleCache)
+	bookmarkService := bookmarkSvc.NewService(repos.Bookmark, repos.Sentence)
+	bookmarkService.SetTodayInvalidator(sentenceService)
 
 	services := &Services{
 		Auth:     authService,
@@ -110,6 +177,9 @@ func NewDependencies(db *gorm.DB, cfg *config.Config) *Dependencies {
 		Learning: learningService,
 		Chat:     chatService,
 		Feedback: feedbackService,
+		Admin:    adminService,
+		Review:   reviewService,
+		Bookmark: bookmarkService,
 		Async:    asyncService,
 	}
 
@@ -119,3 +189,70 @@ func NewDependencies(db *gorm.DB, cfg *config.Config) *Dependencies {
 		Infra:    infra,
 	}
 }
+
+// newOAuthRegistry 설정된 OAuth 공급자만 등록
+func newOAuthRegistry(cfg *config.Config) *pkg.OAuthRegistry {
+	registry := pkg.NewOAuthRegistry()
+
+	if cfg.Google.ClientID != "" && cfg.Google.ClientSecret != "" {
+		registry.Register(pkg.NewGoogleProvider(oauthProviderConfig(cfg.Google)))
+	}
+	if cfg.Kakao.ClientID != "" {
+		registry.Register(pkg.NewKakaoProvider(oauthProviderConfig(cfg.Kakao)))
+	}
+	if cfg.Line.ClientID != "" && cfg.Line.ClientSecret != "" {
+		registry.Register(pkg.NewLineProvider(oauthProviderConfig(cfg.Line)))
+	}
+	if cfg.Apple.ClientID != "" && cfg.Apple.PrivateKey != "" {
+		apple, err := pkg.NewAppleProvider(pkg.AppleProviderConfig{
+			OAuthProviderConfig: oauthProviderConfig(cfg.Apple.OAuthProviderConfig),
+			TeamID:              cfg.Apple.TeamID,
+			KeyID:               cfg.Apple.KeyID,
+			PrivateKey:          cfg.Apple.PrivateKey,
+			Issuer:              cfg.Apple.Issuer,
+		})
+		if err != nil {
+			log.Printf("Warning: Apple OAuth not configured: %v", err)
+		} else {
+			registry.Register(apple)
+		}
+	}
+
+	if names := registry.Names(); len(names) > 0 {
+		log.Printf("OAuth providers initialized: %v", names)
+	} else {
+		log.Println("Warning: no OAuth provider configured")
+	}
+	return registry
+}
+
+// oauthProviderConfig config 값을 pkg 공급자 설정으로 변환
+func oauthProviderConfig(c config.OAuthProviderConfig) pkg.OAuthProviderConfig {
+	return pkg.OAuthProviderConfig{
+		ClientID:     c.ClientID,
+		ClientSecret: c.ClientSecret,
+		RedirectURL:  c.RedirectURL,
+		Endpoints: pkg.OAuthEndpoints{
+			AuthURL:     c.AuthURL,
+			TokenURL:    c.TokenURL,
+			UserInfoURL: c.UserInfoURL,
+		},
+	}
+}
+
+// newMailer SMTP가 설정되어 있으면 SMTP, 아니면 로그 출력 메일러
+func newMailer(cfg *config.Config) pkg.Mailer {
+	if cfg.Mail.SMTPHost == "" {
+		log.Println("Warning: SMTP not configured, mails will be logged only")
+		return pkg.NewLogMailer()
+	}
+	return pkg.NewSMTPMailer(cfg.Mail.SMTPHost, cfg.Mail.SMTPPort, cfg.Mail.SMTPUsername, cfg.Mail.SMTPPassword, cfg.Mail.From)
+}
+
+// emailLinks 인증/재설정 메일에 넣을 링크
+func emailLinks(cfg *config.Config) authSvc.EmailLinks {
+	return authSvc.EmailLinks{
+		VerifyURL: cfg.Mail.VerifyURL,
+		ResetURL:  cfg.Mail.ResetURL,
+	}
+}
@@ -0,0 +1,104 @@
+package cache
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/jptaku/server/internal/pkg"
+	"github.com/redis/go-redis/v9"
+)
+
+// email:token:{purpose}:{sha256(token)} -> userID
+// 메일로 보낸 원문 토큰은 저장하지 않습니다.
+const emailTokenKeyPrefix = "email:token:"
+
+// EmailTokenStore Redis 기반 이메일 인증/비밀번호 재설정 토큰 저장소 (single-use)
+type EmailTokenStore struct {
+	client *redis.Client
+}
+
+// NewEmailTokenStore EmailTokenStore 생성자
+func NewEmailTokenStore(client *redis.Client) *EmailTokenStore {
+	return &EmailTokenStore{client: client}
+}
+
+// Save 토큰 저장
+func (s *EmailTokenStore) Save(ctx context.Context, purpose, token string, userID uint, ttl time.Duration) error {
+	return s.client.Set(ctx, emailTokenKey(purpose, token), userID, ttl).Err()
+}
+
+// Consume 토큰 소비 후 사용자 ID 반환 (한 번만 성공)
+func (s *EmailTokenStore) Consume(ctx context.Context, purpose, token string) (uint, error) {
+	raw, err := s.client.GetDel(ctx, emailTokenKey(purpose, token)).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return 0, pkg.ErrInvalidEmailToken
+		}
+		return 0, err
+	}
+
+	userID, err := strconv.ParseUint(raw, 10, 64)
+	if err != nil {
+		return 0, pkg.ErrInvalidEmailToken
+	}
+	return uint(userID), nil
+}
+
+func emailTokenKey(purpose, token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return emailTokenKeyPrefix + purpose + ":" + hex.EncodeToString(sum[:])
+}
+
+// ========================================
+// In-memory 구현 (Redis 미설정 시 로컬 개발용)
+// ========================================
+
+type memoryEmailToken struct {
+	userID    uint
+	expiresAt time.Time
+}
+
+// MemoryEmailTokenStore 단일 인스턴스용 in-memory 이메일 토큰 저장소
+type MemoryEmailTokenStore struct {
+	mu     sync.Mutex
+	tokens map[string]memoryEmailToken
+}
+
+// NewMemoryEmailTokenStore MemoryEmailTokenStore 생성자
+func NewMemoryEmailTokenStore() *MemoryEmailTokenStore {
+	return &MemoryEmailTokenStore{tokens: make(map[string]memoryEmailToken)}
+}
+
+// Save 토큰 저장
+func (s *MemoryEmailTokenStore) Save(_ context.Context, purpose, token string, userID uint, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	for k, v := range s.tokens {
+		if now.After(v.expiresAt) {
+			delete(s.tokens, k)
+		}
+	}
+	s.tokens[emailTokenKey(purpose, token)] = memoryEmailToken{userID: userID, expiresAt: now.Add(ttl)}
+	return nil
+}
+
+// Consume 토큰 소비 후 사용자 ID 반환 (한 번만 성공)
+func (s *MemoryEmailTokenStore) Consume(_ context.Context, purpose, token string) (uint, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := emailTokenKey(purpose, token)
+	t, ok := s.tokens[key]
+	delete(s.tokens, key)
+	if !ok || time.Now().After(t.expiresAt) {
+		return 0, pkg.ErrInvalidEmailToken
+	}
+	return t.userID, nil
+}
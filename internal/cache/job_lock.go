@@ -0,0 +1,27 @@
+package cache
+
+import (
+	"context"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// lock:job:{name} -> 잠금 (TTL 후 자동 해제)
+const jobLockKeyPrefix = "lock:job:"
+
+// JobLock 여러 인스턴스 중 한 곳에서만 예약 작업을 실행하기 위한 Redis 잠금
+type JobLock struct {
+	client *redis.Client
+}
+
+// NewJobLock JobLock 생성자
+func NewJobLock(client *redis.Client) *JobLock {
+	return &JobLock{client: client}
+}
+
+// TryAcquire 잠금 획득 (다른 인스턴스가 이미 잡았으면 false)
+// 같은 예약 시각에 뒤늦게 실행된 인스턴스가 다시 돌지 않도록 풀지 않고 TTL로만 만료시킵니다.
+func (l *JobLock) TryAcquire(ctx context.Context, name string, ttl time.Duration) (bool, error) {
+	return l.client.SetNX(ctx, jobLockKeyPrefix+name, time.Now().Unix(), ttl).Result()
+}
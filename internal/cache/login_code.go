@@ -0,0 +1,107 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/jptaku/server/internal/pkg"
+	"github.com/redis/go-redis/v9"
+)
+
+// oauth:code:{code} -> {"user_id":..,"challenge":..}
+const loginCodeKeyPrefix = "oauth:code:"
+
+type loginCodeValue struct {
+	UserID    uint   `json:"user_id"`
+	Challenge string `json:"challenge"`
+}
+
+// LoginCodeStore Redis 기반 1회용 로그인 코드 저장소
+type LoginCodeStore struct {
+	client *redis.Client
+}
+
+// NewLoginCodeStore LoginCodeStore 생성자
+func NewLoginCodeStore(client *redis.Client) *LoginCodeStore {
+	return &LoginCodeStore{client: client}
+}
+
+// Save 로그인 코드 저장 (클라이언트 code_challenge와 함께)
+func (s *LoginCodeStore) Save(ctx context.Context, code string, userID uint, challenge string, ttl time.Duration) error {
+	value, err := json.Marshal(loginCodeValue{UserID: userID, Challenge: challenge})
+	if err != nil {
+		return err
+	}
+	return s.client.Set(ctx, loginCodeKeyPrefix+code, value, ttl).Err()
+}
+
+// Consume 로그인 코드 소비 (한 번만 성공)
+func (s *LoginCodeStore) Consume(ctx context.Context, code string) (uint, string, error) {
+	raw, err := s.client.GetDel(ctx, loginCodeKeyPrefix+code).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return 0, "", pkg.ErrInvalidLoginCode
+		}
+		return 0, "", err
+	}
+
+	var value loginCodeValue
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return 0, "", err
+	}
+	return value.UserID, value.Challenge, nil
+}
+
+// ========================================
+// In-memory 구현 (Redis 미설정 시 로컬 개발용)
+// ========================================
+
+type memoryLoginCode struct {
+	loginCodeValue
+	expiresAt time.Time
+}
+
+// MemoryLoginCodeStore 단일 인스턴스용 in-memory 로그인 코드 저장소
+type MemoryLoginCodeStore struct {
+	mu    sync.Mutex
+	codes map[string]memoryLoginCode
+}
+
+// NewMemoryLoginCodeStore MemoryLoginCodeStore 생성자
+func NewMemoryLoginCodeStore() *MemoryLoginCodeStore {
+	return &MemoryLoginCodeStore{codes: make(map[string]memoryLoginCode)}
+}
+
+// Save 로그인 코드 저장 (클라이언트 code_challenge와 함께)
+func (s *MemoryLoginCodeStore) Save(_ context.Context, code string, userID uint, challenge string, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	for k, v := range s.codes {
+		if now.After(v.expiresAt) {
+			delete(s.codes, k)
+		}
+	}
+	s.codes[code] = memoryLoginCode{
+		loginCodeValue: loginCodeValue{UserID: userID, Challenge: challenge},
+		expiresAt:      now.Add(ttl),
+	}
+	return nil
+}
+
+// Consume 로그인 코드 소비 (한 번만 성공)
+func (s *MemoryLoginCodeStore) Consume(_ context.Context, code string) (uint, string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	value, ok := s.codes[code]
+	delete(s.codes, code)
+	if !ok || time.Now().After(value.expiresAt) {
+		return 0, "", pkg.ErrInvalidLoginCode
+	}
+	return value.UserID, value.Challenge, nil
+}
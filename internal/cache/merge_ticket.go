@@ -0,0 +1,107 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/jptaku/server/internal/pkg"
+	"github.com/redis/go-redis/v9"
+)
+
+// account:merge:{ticket} -> {"target":..,"source":..}
+const mergeTicketKeyPrefix = "account:merge:"
+
+type mergeTicketValue struct {
+	TargetUserID uint `json:"target"`
+	SourceUserID uint `json:"source"`
+}
+
+// MergeTicketStore Redis 기반 계정 병합 티켓 저장소
+type MergeTicketStore struct {
+	client *redis.Client
+}
+
+// NewMergeTicketStore MergeTicketStore 생성자
+func NewMergeTicketStore(client *redis.Client) *MergeTicketStore {
+	return &MergeTicketStore{client: client}
+}
+
+// Save 병합 티켓 저장 (source 계정을 target 계정으로 합침)
+func (s *MergeTicketStore) Save(ctx context.Context, ticket string, targetUserID, sourceUserID uint, ttl time.Duration) error {
+	value, err := json.Marshal(mergeTicketValue{TargetUserID: targetUserID, SourceUserID: sourceUserID})
+	if err != nil {
+		return err
+	}
+	return s.client.Set(ctx, mergeTicketKeyPrefix+ticket, value, ttl).Err()
+}
+
+// Consume 병합 티켓 소비 (한 번만 성공)
+func (s *MergeTicketStore) Consume(ctx context.Context, ticket string) (uint, uint, error) {
+	raw, err := s.client.GetDel(ctx, mergeTicketKeyPrefix+ticket).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return 0, 0, pkg.ErrInvalidMergeTicket
+		}
+		return 0, 0, err
+	}
+
+	var value mergeTicketValue
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return 0, 0, err
+	}
+	return value.TargetUserID, value.SourceUserID, nil
+}
+
+// ========================================
+// In-memory 구현 (Redis 미설정 시 로컬 개발용)
+// ========================================
+
+type memoryMergeTicket struct {
+	mergeTicketValue
+	expiresAt time.Time
+}
+
+// MemoryMergeTicketStore 단일 인스턴스용 in-memory 병합 티켓 저장소
+type MemoryMergeTicketStore struct {
+	mu      sync.Mutex
+	tickets map[string]memoryMergeTicket
+}
+
+// NewMemoryMergeTicketStore MemoryMergeTicketStore 생성자
+func NewMemoryMergeTicketStore() *MemoryMergeTicketStore {
+	return &MemoryMergeTicketStore{tickets: make(map[string]memoryMergeTicket)}
+}
+
+// Save 병합 티켓 저장 (source 계정을 target 계정으로 합침)
+func (s *MemoryMergeTicketStore) Save(_ context.Context, ticket string, targetUserID, sourceUserID uint, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	for k, v := range s.tickets {
+		if now.After(v.expiresAt) {
+			delete(s.tickets, k)
+		}
+	}
+	s.tickets[ticket] = memoryMergeTicket{
+		mergeTicketValue: mergeTicketValue{TargetUserID: targetUserID, SourceUserID: sourceUserID},
+		expiresAt:        now.Add(ttl),
+	}
+	return nil
+}
+
+// Consume 병합 티켓 소비 (한 번만 성공)
+func (s *MemoryMergeTicketStore) Consume(_ context.Context, ticket string) (uint, uint, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	value, ok := s.tickets[ticket]
+	delete(s.tickets, ticket)
+	if !ok || time.Now().After(value.expiresAt) {
+		return 0, 0, pkg.ErrInvalidMergeTicket
+	}
+	return value.TargetUserID, value.SourceUserID, nil
+}
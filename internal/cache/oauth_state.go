@@ -0,0 +1,89 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/jptaku/server/internal/pkg"
+	"github.com/redis/go-redis/v9"
+)
+
+// oauth:state:{nonce} -> PKCE code_verifier
+const oauthStateKeyPrefix = "oauth:state:"
+
+// OAuthStateStore Redis 기반 OAuth state 저장소 (single-use)
+type OAuthStateStore struct {
+	client *redis.Client
+}
+
+// NewOAuthStateStore OAuthStateStore 생성자
+func NewOAuthStateStore(client *redis.Client) *OAuthStateStore {
+	return &OAuthStateStore{client: client}
+}
+
+// Save state nonce와 PKCE verifier 저장
+func (s *OAuthStateStore) Save(ctx context.Context, nonce, verifier string, ttl time.Duration) error {
+	return s.client.Set(ctx, oauthStateKeyPrefix+nonce, verifier, ttl).Err()
+}
+
+// Consume state를 소비하고 verifier 반환 (두 번째 호출부터는 실패)
+func (s *OAuthStateStore) Consume(ctx context.Context, nonce string) (string, error) {
+	verifier, err := s.client.GetDel(ctx, oauthStateKeyPrefix+nonce).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return "", pkg.ErrOAuthStateUsed
+		}
+		return "", err
+	}
+	return verifier, nil
+}
+
+// ========================================
+// In-memory 구현 (Redis 미설정 시 로컬 개발용)
+// ========================================
+
+type memoryOAuthState struct {
+	verifier  string
+	expiresAt time.Time
+}
+
+// MemoryOAuthStateStore 단일 인스턴스용 in-memory OAuth state 저장소
+type MemoryOAuthStateStore struct {
+	mu     sync.Mutex
+	states map[string]memoryOAuthState
+}
+
+// NewMemoryOAuthStateStore MemoryOAuthStateStore 생성자
+func NewMemoryOAuthStateStore() *MemoryOAuthStateStore {
+	return &MemoryOAuthStateStore{states: make(map[string]memoryOAuthState)}
+}
+
+// Save state nonce와 PKCE verifier 저장
+func (s *MemoryOAuthStateStore) Save(_ context.Context, nonce, verifier string, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	for k, v := range s.states {
+		if now.After(v.expiresAt) {
+			delete(s.states, k)
+		}
+	}
+	s.states[nonce] = memoryOAuthState{verifier: verifier, expiresAt: now.Add(ttl)}
+	return nil
+}
+
+// Consume state를 소비하고 verifier 반환 (두 번째 호출부터는 실패)
+func (s *MemoryOAuthStateStore) Consume(_ context.Context, nonce string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	state, ok := s.states[nonce]
+	delete(s.states, nonce)
+	if !ok || time.Now().After(state.expiresAt) {
+		return "", pkg.ErrOAuthStateUsed
+	}
+	return state.verifier, nil
+}
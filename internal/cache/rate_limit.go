@@ -0,0 +1,192 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math/rand"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/jptaku/server/internal/pkg"
+	"github.com/redis/go-redis/v9"
+)
+
+// ratelimit:{policy}:{subject} -> ZSET of 요청 (score = 요청 시각 ms)
+const rateLimitKeyPrefix = "ratelimit:"
+
+// rateLimitErrorLogInterval Redis 장애 로그 출력 간격 (요청마다 찍히지 않도록)
+const rateLimitErrorLogInterval = 30 * time.Second
+
+// slidingWindowScript 윈도우 밖 요청을 지우고, 자리가 있으면 이번 요청을 기록합니다.
+// 반환: {허용 여부(1/0), 윈도우 안 요청 수, 한 자리가 비기까지 남은 ms}
+var slidingWindowScript = redis.NewScript(`
+local now = tonumber(ARGV[1])
+local window = tonumber(ARGV[2])
+local limit = tonumber(ARGV[3])
+
+redis.call('ZREMRANGEBYSCORE', KEYS[1], '-inf', now - window)
+local count = redis.call('ZCARD', KEYS[1])
+local allowed = 0
+if count < limit then
+	redis.call('ZADD', KEYS[1], now, ARGV[4])
+	count = count + 1
+	allowed = 1
+end
+redis.call('PEXPIRE', KEYS[1], window)
+
+local reset = window
+local oldest = redis.call('ZRANGE', KEYS[1], 0, 0, 'WITHSCORES')
+if oldest[2] then
+	reset = tonumber(oldest[2]) + window - now
+end
+return {allowed, count, reset}
+`)
+
+// RateLimiter Redis 기반 sliding window 요청 제한기
+// 여러 API 인스턴스가 같은 카운터를 공유합니다. Redis 호출이 실패하면 인스턴스별 in-memory 제한기로 대신 판정합니다.
+type RateLimiter struct {
+	client   *redis.Client
+	fallback *MemoryRateLimiter
+	now      func() time.Time
+
+	mu           sync.Mutex
+	lastErrorLog time.Time
+}
+
+// NewRateLimiter RateLimiter 생성자
+func NewRateLimiter(client *redis.Client) *RateLimiter {
+	return &RateLimiter{
+		client:   client,
+		fallback: NewMemoryRateLimiter(),
+		now:      time.Now,
+	}
+}
+
+// Allow subject(사용자/IP)의 요청 한 건을 정책에 따라 판정
+func (l *RateLimiter) Allow(ctx context.Context, policy pkg.RateLimitPolicy, subject string) (*pkg.RateLimitResult, error) {
+	now := l.now()
+	key := rateLimitKey(policy, subject)
+	member := strconv.FormatInt(now.UnixNano(), 10) + "-" + strconv.Itoa(rand.Int())
+	args := []interface{}{now.UnixMilli(), policy.Window.Milliseconds(), policy.Limit, member}
+
+	res, err := slidingWindowScript.Run(ctx, l.client, []string{key}, args...).Int64Slice()
+	if err == nil && len(res) != 3 {
+		err = fmt.Errorf("unexpected rate limit result: %v", res)
+	}
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		l.logError(err)
+		return l.fallback.Allow(ctx, policy, subject)
+	}
+
+	return &pkg.RateLimitResult{
+		Allowed:   res[0] == 1,
+		Limit:     policy.Limit,
+		Remaining: policy.Limit - int(res[1]),
+		Reset:     time.Duration(res[2]) * time.Millisecond,
+	}, nil
+}
+
+// logError Redis 장애 로그 (rateLimitErrorLogInterval마다 한 번)
+func (l *RateLimiter) logError(err error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if time.Since(l.lastErrorLog) < rateLimitErrorLogInterval {
+		return
+	}
+	l.lastErrorLog = time.Now()
+	log.Printf("Rate limiter falling back to memory: %v", err)
+}
+
+func rateLimitKey(policy pkg.RateLimitPolicy, subject string) string {
+	return rateLimitKeyPrefix + policy.Name + ":" + subject
+}
+
+// ========================================
+// In-memory 구현 (Redis 미설정/장애 시)
+// ========================================
+
+// memoryRateLimitSweepInterval 오래된 키 정리 주기
+const memoryRateLimitSweepInterval = time.Minute
+
+type memoryRateWindow struct {
+	hits      []time.Time
+	expiresAt time.Time
+}
+
+// MemoryRateLimiter 단일 인스턴스용 in-memory sliding window 요청 제한기
+type MemoryRateLimiter struct {
+	mu        sync.Mutex
+	windows   map[string]*memoryRateWindow
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+// NewMemoryRateLimiter MemoryRateLimiter 생성자
+func NewMemoryRateLimiter() *MemoryRateLimiter {
+	return &MemoryRateLimiter{
+		windows: make(map[string]*memoryRateWindow),
+		now:     time.Now,
+	}
+}
+
+// Allow subject(사용자/IP)의 요청 한 건을 정책에 따라 판정
+func (l *MemoryRateLimiter) Allow(_ context.Context, policy pkg.RateLimitPolicy, subject string) (*pkg.RateLimitResult, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.sweep(now)
+
+	key := rateLimitKey(policy, subject)
+	w, ok := l.windows[key]
+	if !ok {
+		w = &memoryRateWindow{}
+		l.windows[key] = w
+	}
+
+	// 윈도우 밖 요청 제거
+	cutoff := now.Add(-policy.Window)
+	i := 0
+	for i < len(w.hits) && !w.hits[i].After(cutoff) {
+		i++
+	}
+	w.hits = w.hits[i:]
+
+	allowed := len(w.hits) < policy.Limit
+	if allowed {
+		w.hits = append(w.hits, now)
+	}
+	w.expiresAt = now.Add(policy.Window)
+
+	reset := policy.Window
+	if len(w.hits) > 0 {
+		reset = w.hits[0].Add(policy.Window).Sub(now)
+	}
+
+	return &pkg.RateLimitResult{
+		Allowed:   allowed,
+		Limit:     policy.Limit,
+		Remaining: policy.Limit - len(w.hits),
+		Reset:     reset,
+	}, nil
+}
+
+// sweep 만료된 윈도우 정리 (mu를 잡은 상태에서 호출)
+func (l *MemoryRateLimiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < memoryRateLimitSweepInterval {
+		return
+	}
+	l.lastSweep = now
+
+	for key, w := range l.windows {
+		if now.After(w.expiresAt) {
+			delete(l.windows, key)
+		}
+	}
+}
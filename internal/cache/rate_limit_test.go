@@ -0,0 +1,153 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/jptaku/server/internal/pkg"
+	"github.com/redis/go-redis/v9"
+)
+
+// newTestRateLimiter miniredis에 연결된 RateLimiter와 조작 가능한 현재 시각
+func newTestRateLimiter(t *testing.T) (*RateLimiter, *miniredis.Miniredis, *time.Time) {
+	t.Helper()
+
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { client.Close() })
+
+	now := time.Date(2026, 1, 1, 9, 0, 0, 0, time.UTC)
+	limiter := NewRateLimiter(client)
+	limiter.now = func() time.Time { return now }
+	return limiter, mr, &now
+}
+
+func TestRateLimiterSlidingWindow(t *testing.T) {
+	limiter, mr, now := newTestRateLimiter(t)
+	policy := pkg.RateLimitPolicy{Name: "test", Limit: 3, Window: time.Minute}
+	start := *now
+
+	steps := []struct {
+		at            time.Duration // start 기준 요청 시각
+		wantAllowed   bool
+		wantRemaining int
+		wantReset     time.Duration
+	}{
+		{0, true, 2, time.Minute},
+		{10 * time.Second, true, 1, 50 * time.Second}, // 첫 요청이 빠지기까지 남은 시간
+		{20 * time.Second, true, 0, 40 * time.Second},
+		{30 * time.Second, false, 0, 30 * time.Second}, // 가득 참: 가장 오래된 요청이 빠질 때까지
+		{59 * time.Second, false, 0, time.Second},
+		{60 * time.Second, true, 0, 10 * time.Second}, // 첫 요청이 윈도우를 벗어남
+		{65 * time.Second, false, 0, 5 * time.Second},
+		{81 * time.Second, true, 1, 39 * time.Second}, // 10s, 20s 요청이 빠지고 60s, 81s만 남음
+	}
+	for _, step := range steps {
+		*now = start.Add(step.at)
+		result, err := limiter.Allow(context.Background(), policy, "user:1")
+		if err != nil {
+			t.Fatalf("at %v: Allow() error = %v", step.at, err)
+		}
+		if result.Allowed != step.wantAllowed || result.Remaining != step.wantRemaining || result.Reset != step.wantReset {
+			t.Errorf("at %v: got allowed=%v remaining=%d reset=%v, want allowed=%v remaining=%d reset=%v",
+				step.at, result.Allowed, result.Remaining, result.Reset, step.wantAllowed, step.wantRemaining, step.wantReset)
+		}
+		if result.Limit != policy.Limit {
+			t.Errorf("at %v: limit = %d, want %d", step.at, result.Limit, policy.Limit)
+		}
+	}
+
+	// 키는 윈도우가 지나면 만료됩니다.
+	if ttl := mr.TTL(rateLimitKey(policy, "user:1")); ttl != policy.Window {
+		t.Errorf("key ttl = %v, want %v", ttl, policy.Window)
+	}
+}
+
+func TestRateLimiterSeparatesSubjectsAndPolicies(t *testing.T) {
+	limiter, _, _ := newTestRateLimiter(t)
+	auth := pkg.RateLimitPolicy{Name: pkg.RateLimitAuth, Limit: 1, Window: time.Minute}
+	chat := pkg.RateLimitPolicy{Name: pkg.RateLimitChat, Limit: 1, Window: time.Minute}
+
+	tests := []struct {
+		policy  pkg.RateLimitPolicy
+		subject string
+		want    bool
+	}{
+		{auth, "ip:10.0.0.1", true},
+		{auth, "ip:10.0.0.1", false},
+		{auth, "ip:10.0.0.2", true},
+		{chat, "ip:10.0.0.1", true},
+		{chat, "user:1", true},
+		{chat, "user:1", false},
+	}
+	for i, tt := range tests {
+		result, err := limiter.Allow(context.Background(), tt.policy, tt.subject)
+		if err != nil {
+			t.Fatalf("#%d: Allow() error = %v", i, err)
+		}
+		if result.Allowed != tt.want {
+			t.Errorf("#%d Allow(%s, %s) allowed = %v, want %v", i, tt.policy.Name, tt.subject, result.Allowed, tt.want)
+		}
+	}
+}
+
+func TestRateLimiterFallsBackToMemory(t *testing.T) {
+	limiter, mr, _ := newTestRateLimiter(t)
+	policy := pkg.RateLimitPolicy{Name: "test", Limit: 2, Window: time.Minute}
+
+	mr.SetError("ERR redis unavailable")
+
+	wants := []bool{true, true, false}
+	for i, want := range wants {
+		result, err := limiter.Allow(context.Background(), policy, "user:1")
+		if err != nil {
+			t.Fatalf("#%d: Allow() error = %v, want fallback result", i, err)
+		}
+		if result.Allowed != want {
+			t.Errorf("#%d: allowed = %v, want %v", i, result.Allowed, want)
+		}
+	}
+}
+
+func TestRateLimiterCanceledContext(t *testing.T) {
+	limiter, _, _ := newTestRateLimiter(t)
+	policy := pkg.RateLimitPolicy{Name: "test", Limit: 1, Window: time.Minute}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// 요청이 취소된 것은 Redis 장애가 아니므로 memory로 판정하지 않습니다.
+	if _, err := limiter.Allow(ctx, policy, "user:1"); err == nil {
+		t.Fatal("Allow() with canceled context: want error")
+	}
+}
+
+func TestMemoryRateLimiterSlidingWindow(t *testing.T) {
+	limiter := NewMemoryRateLimiter()
+	start := time.Date(2026, 1, 1, 9, 0, 0, 0, time.UTC)
+	now := start
+	limiter.now = func() time.Time { return now }
+	policy := pkg.RateLimitPolicy{Name: "test", Limit: 2, Window: time.Minute}
+
+	steps := []struct {
+		at            time.Duration
+		wantAllowed   bool
+		wantRemaining int
+		wantReset     time.Duration
+	}{
+		{0, true, 1, time.Minute},
+		{30 * time.Second, true, 0, 30 * time.Second},
+		{45 * time.Second, false, 0, 15 * time.Second},
+		{60 * time.Second, true, 0, 30 * time.Second},
+	}
+	for _, step := range steps {
+		now = start.Add(step.at)
+		result, _ := limiter.Allow(context.Background(), policy, "ip:10.0.0.1")
+		if result.Allowed != step.wantAllowed || result.Remaining != step.wantRemaining || result.Reset != step.wantReset {
+			t.Errorf("at %v: got allowed=%v remaining=%d reset=%v, want allowed=%v remaining=%d reset=%v",
+				step.at, result.Allowed, result.Remaining, result.Reset, step.wantAllowed, step.wantRemaining, step.wantReset)
+		}
+	}
+}
@@ -0,0 +1,214 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"math/rand/v2"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"golang.org/x/sync/singleflight"
+)
+
+// ttlJitter 같은 시점에 채워진 키가 한꺼번에 만료되지 않도록 TTL에 더하는 최대 비율
+const ttlJitter = 0.1
+
+// Store 캐시 저장소 인터페이스 (값은 JSON 바이트)
+// 없는 키는 Get에서 (nil, nil), MGet에서 nil 원소로 돌려줍니다.
+type Store interface {
+	Get(ctx context.Context, key string) ([]byte, error)
+	MGet(ctx context.Context, keys []string) ([][]byte, error)
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+	Delete(ctx context.Context, keys ...string) error
+}
+
+// ReadThrough 읽기 관통(read-through) 캐시
+// 캐시에 없으면 load로 읽어 채우고, 같은 키를 동시에 읽으면 인스턴스 안에서는 load를 한 번만 실행합니다.
+// 캐시 저장소 오류는 로그만 남기고 load 결과를 그대로 사용합니다.
+type ReadThrough struct {
+	store Store
+	group singleflight.Group
+}
+
+// NewReadThrough ReadThrough 생성자 (client가 nil이면 캐시 없이 항상 load)
+func NewReadThrough(client *redis.Client) *ReadThrough {
+	if client == nil {
+		return &ReadThrough{store: NopStore{}}
+	}
+	return &ReadThrough{store: NewRedisStore(client)}
+}
+
+// Fetch key의 값을 dest에 읽음 (없으면 load 결과를 ttl 동안 저장)
+func (c *ReadThrough) Fetch(ctx context.Context, key string, ttl time.Duration, dest any, load func() (any, error)) error {
+	if data, err := c.store.Get(ctx, key); err != nil {
+		log.Printf("Cache get failed: key=%s err=%v", key, err)
+	} else if data != nil && json.Unmarshal(data, dest) == nil {
+		return nil
+	}
+
+	v, err, _ := c.group.Do(key, func() (any, error) {
+		value, err := load()
+		if err != nil {
+			return nil, err
+		}
+		data, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+		c.set(ctx, key, data, ttl)
+		return data, nil
+	})
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(v.([]byte), dest)
+}
+
+// Delete 키 무효화
+func (c *ReadThrough) Delete(ctx context.Context, keys ...string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	return c.store.Delete(ctx, keys...)
+}
+
+// set TTL에 jitter를 더해 저장 (실패해도 요청은 계속 진행)
+func (c *ReadThrough) set(ctx context.Context, key string, data []byte, ttl time.Duration) {
+	if ttl > 0 {
+		ttl += time.Duration(rand.Int64N(int64(float64(ttl)*ttlJitter) + 1))
+	}
+	if err := c.store.Set(ctx, key, data, ttl); err != nil {
+		log.Printf("Cache set failed: key=%s err=%v", key, err)
+	}
+}
+
+// FetchMany 여러 키를 한 번에 읽음
+// 캐시에 없는 ID만 모아 load를 한 번 호출하고, 결과를 ID별로 저장합니다. load가 돌려주지 않은 ID는 결과에서 빠집니다.
+func FetchMany[K comparable, V any](ctx context.Context, c *ReadThrough, ids []K, key func(K) string, ttl time.Duration, load func(missing []K) (map[K]V, error)) (map[K]V, error) {
+	result := make(map[K]V, len(ids))
+	if len(ids) == 0 {
+		return result, nil
+	}
+
+	keys := make([]string, len(ids))
+	for i, id := range ids {
+		keys[i] = key(id)
+	}
+
+	values, err := c.store.MGet(ctx, keys)
+	if err != nil {
+		log.Printf("Cache mget failed: err=%v", err)
+		values = nil
+	}
+
+	var missing []K
+	var missingKeys []string
+	for i, id := range ids {
+		if values != nil && values[i] != nil {
+			var v V
+			if json.Unmarshal(values[i], &v) == nil {
+				result[id] = v
+				continue
+			}
+		}
+		missing = append(missing, id)
+		missingKeys = append(missingKeys, keys[i])
+	}
+	if len(missing) == 0 {
+		return result, nil
+	}
+
+	v, err, _ := c.group.Do(strings.Join(missingKeys, ","), func() (any, error) {
+		loaded, err := load(missing)
+		if err != nil {
+			return nil, err
+		}
+		for id, value := range loaded {
+			if data, err := json.Marshal(value); err == nil {
+				c.set(ctx, key(id), data, ttl)
+			}
+		}
+		return loaded, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	for id, value := range v.(map[K]V) {
+		result[id] = value
+	}
+	return result, nil
+}
+
+// ========================================
+// Redis 구현
+// ========================================
+
+// RedisStore Redis 기반 캐시 저장소
+type RedisStore struct {
+	client *redis.Client
+}
+
+// NewRedisStore RedisStore 생성자
+func NewRedisStore(client *redis.Client) *RedisStore {
+	return &RedisStore{client: client}
+}
+
+// Get 값 조회 (없으면 nil)
+func (s *RedisStore) Get(ctx context.Context, key string) ([]byte, error) {
+	data, err := s.client.Get(ctx, key).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	return data, err
+}
+
+// MGet 여러 값 조회 (없는 키는 nil)
+func (s *RedisStore) MGet(ctx context.Context, keys []string) ([][]byte, error) {
+	res, err := s.client.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([][]byte, len(res))
+	for i, v := range res {
+		if str, ok := v.(string); ok {
+			values[i] = []byte(str)
+		}
+	}
+	return values, nil
+}
+
+// Set 값 저장
+func (s *RedisStore) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	return s.client.Set(ctx, key, value, ttl).Err()
+}
+
+// Delete 값 삭제
+func (s *RedisStore) Delete(ctx context.Context, keys ...string) error {
+	return s.client.Del(ctx, keys...).Err()
+}
+
+// ========================================
+// No-op 구현 (Redis 미설정 시)
+// ========================================
+
+// NopStore 아무것도 저장하지 않는 캐시 저장소 (항상 원본을 읽음)
+// 인스턴스별 메모리 캐시는 무효화가 다른 인스턴스에 전달되지 않으므로 두지 않습니다.
+type NopStore struct{}
+
+// Get 항상 없음
+func (NopStore) Get(context.Context, string) ([]byte, error) { return nil, nil }
+
+// MGet 항상 없음
+func (NopStore) MGet(_ context.Context, keys []string) ([][]byte, error) {
+	return make([][]byte, len(keys)), nil
+}
+
+// Set 저장하지 않음
+func (NopStore) Set(context.Context, string, []byte, time.Duration) error { return nil }
+
+// Delete 삭제할 것이 없음
+func (NopStore) Delete(context.Context, ...string) error { return nil }
@@ -0,0 +1,212 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/jptaku/server/internal/pkg"
+	"github.com/redis/go-redis/v9"
+)
+
+// Redis 키 구조
+//
+//	refresh:token:{jti}   -> familyID (활성 토큰, TTL = 토큰 만료)
+//	refresh:used:{jti}    -> familyID (이미 로테이션된 토큰, 재사용 감지용)
+//	refresh:family:{fid}  -> SET of jti
+//	refresh:user:{uid}    -> SET of familyID
+const (
+	refreshTokenKeyPrefix  = "refresh:token:"
+	refreshUsedKeyPrefix   = "refresh:used:"
+	refreshFamilyKeyPrefix = "refresh:family:"
+	refreshUserKeyPrefix   = "refresh:user:"
+)
+
+// consumeScript 활성 토큰을 원자적으로 소비하고 used 마커를 남깁니다.
+var consumeScript = redis.NewScript(`
+local fid = redis.call('GET', KEYS[1])
+if fid then
+	local ttl = redis.call('PTTL', KEYS[1])
+	if ttl < 1 then ttl = 1 end
+	redis.call('DEL', KEYS[1])
+	redis.call('SET', KEYS[2], fid, 'PX', ttl)
+	return {'ok', fid}
+end
+local used = redis.call('GET', KEYS[2])
+if used then
+	return {'reused', used}
+end
+return {'missing', ''}
+`)
+
+// RefreshTokenStore Redis 기반 refresh token 저장소
+type RefreshTokenStore struct {
+	client *redis.Client
+}
+
+// NewRefreshTokenStore RefreshTokenStore 생성자
+func NewRefreshTokenStore(client *redis.Client) *RefreshTokenStore {
+	return &RefreshTokenStore{client: client}
+}
+
+// Save 새로 발급한 refresh token 저장
+func (s *RefreshTokenStore) Save(ctx context.Context, userID uint, tokenID, familyID string, ttl time.Duration) error {
+	familyKey := refreshFamilyKeyPrefix + familyID
+	userKey := refreshUserKey(userID)
+
+	pipe := s.client.TxPipeline()
+	pipe.Set(ctx, refreshTokenKeyPrefix+tokenID, familyID, ttl)
+	pipe.SAdd(ctx, familyKey, tokenID)
+	pipe.Expire(ctx, familyKey, ttl)
+	pipe.SAdd(ctx, userKey, familyID)
+	pipe.Expire(ctx, userKey, ttl)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// Consume 토큰을 한 번만 사용할 수 있도록 소비
+// 이미 소비된 토큰이면 familyID와 함께 pkg.ErrTokenReused를 반환합니다.
+func (s *RefreshTokenStore) Consume(ctx context.Context, tokenID string) (string, error) {
+	keys := []string{refreshTokenKeyPrefix + tokenID, refreshUsedKeyPrefix + tokenID}
+	res, err := consumeScript.Run(ctx, s.client, keys).StringSlice()
+	if err != nil {
+		return "", err
+	}
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected consume result: %v", res)
+	}
+
+	switch res[0] {
+	case "ok":
+		return res[1], nil
+	case "reused":
+		return res[1], pkg.ErrTokenReused
+	default:
+		return "", pkg.ErrTokenRevoked
+	}
+}
+
+// RevokeFamily 같은 family의 모든 토큰 폐기
+func (s *RefreshTokenStore) RevokeFamily(ctx context.Context, familyID string) error {
+	familyKey := refreshFamilyKeyPrefix + familyID
+	tokenIDs, err := s.client.SMembers(ctx, familyKey).Result()
+	if err != nil {
+		return err
+	}
+
+	keys := make([]string, 0, len(tokenIDs)+1)
+	for _, id := range tokenIDs {
+		keys = append(keys, refreshTokenKeyPrefix+id)
+	}
+	keys = append(keys, familyKey)
+	return s.client.Del(ctx, keys...).Err()
+}
+
+// RevokeUser 사용자의 모든 refresh token 폐기
+func (s *RefreshTokenStore) RevokeUser(ctx context.Context, userID uint) error {
+	userKey := refreshUserKey(userID)
+	familyIDs, err := s.client.SMembers(ctx, userKey).Result()
+	if err != nil {
+		return err
+	}
+
+	for _, fid := range familyIDs {
+		if err := s.RevokeFamily(ctx, fid); err != nil {
+			return err
+		}
+	}
+	return s.client.Del(ctx, userKey).Err()
+}
+
+func refreshUserKey(userID uint) string {
+	return fmt.Sprintf("%s%d", refreshUserKeyPrefix, userID)
+}
+
+// ========================================
+// In-memory 구현 (Redis 미설정 시 로컬 개발용)
+// ========================================
+
+type memoryRefreshToken struct {
+	userID    uint
+	familyID  string
+	used      bool
+	expiresAt time.Time
+}
+
+// MemoryRefreshTokenStore 단일 인스턴스용 in-memory refresh token 저장소
+type MemoryRefreshTokenStore struct {
+	mu     sync.Mutex
+	tokens map[string]*memoryRefreshToken
+}
+
+// NewMemoryRefreshTokenStore MemoryRefreshTokenStore 생성자
+func NewMemoryRefreshTokenStore() *MemoryRefreshTokenStore {
+	return &MemoryRefreshTokenStore{tokens: make(map[string]*memoryRefreshToken)}
+}
+
+// Save 새로 발급한 refresh token 저장
+func (s *MemoryRefreshTokenStore) Save(_ context.Context, userID uint, tokenID, familyID string, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.purgeExpired()
+	s.tokens[tokenID] = &memoryRefreshToken{
+		userID:    userID,
+		familyID:  familyID,
+		expiresAt: time.Now().Add(ttl),
+	}
+	return nil
+}
+
+// Consume 토큰을 한 번만 사용할 수 있도록 소비
+func (s *MemoryRefreshTokenStore) Consume(_ context.Context, tokenID string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t, ok := s.tokens[tokenID]
+	if !ok || time.Now().After(t.expiresAt) {
+		return "", pkg.ErrTokenRevoked
+	}
+	if t.used {
+		return t.familyID, pkg.ErrTokenReused
+	}
+	t.used = true
+	return t.familyID, nil
+}
+
+// RevokeFamily 같은 family의 모든 토큰 폐기
+func (s *MemoryRefreshTokenStore) RevokeFamily(_ context.Context, familyID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for id, t := range s.tokens {
+		if t.familyID == familyID && !t.used {
+			delete(s.tokens, id)
+		}
+	}
+	return nil
+}
+
+// RevokeUser 사용자의 모든 refresh token 폐기
+func (s *MemoryRefreshTokenStore) RevokeUser(_ context.Context, userID uint) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for id, t := range s.tokens {
+		if t.userID == userID && !t.used {
+			delete(s.tokens, id)
+		}
+	}
+	return nil
+}
+
+// purgeExpired 만료된 토큰 정리 (mu를 잡은 상태에서 호출)
+func (s *MemoryRefreshTokenStore) purgeExpired() {
+	now := time.Now()
+	for id, t := range s.tokens {
+		if now.After(t.expiresAt) {
+			delete(s.tokens, id)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package cache
+
+import (
+	"sync"
+	"time"
+
+	"github.com/jptaku/server/internal/pkg"
+)
+
+// RoleSource 사용자 현재 역할 조회 (repository.UserRepository)
+type RoleSource interface {
+	GetRole(userID uint) (string, error)
+}
+
+type roleEntry struct {
+	role      pkg.Role
+	expiresAt time.Time
+}
+
+// RoleCache 사용자 역할 in-process 캐시
+// Access Token의 role 클레임 대신 DB의 현재 역할로 권한을 확인할 때 씁니다.
+// 역할 변경은 같은 인스턴스에서는 즉시, 다른 인스턴스에서는 TTL 안에 반영됩니다.
+type RoleCache struct {
+	source RoleSource
+	ttl    time.Duration
+
+	mu      sync.Mutex
+	entries map[uint]roleEntry
+}
+
+// NewRoleCache RoleCache 생성자
+func NewRoleCache(source RoleSource, ttl time.Duration) *RoleCache {
+	return &RoleCache{
+		source:  source,
+		ttl:     ttl,
+		entries: make(map[uint]roleEntry),
+	}
+}
+
+// CurrentRole 사용자의 현재 역할 (캐시가 만료되었으면 DB 조회)
+func (c *RoleCache) CurrentRole(userID uint) (pkg.Role, error) {
+	now := time.Now()
+
+	c.mu.Lock()
+	entry, ok := c.entries[userID]
+	c.mu.Unlock()
+	if ok && now.Before(entry.expiresAt) {
+		return entry.role, nil
+	}
+
+	role, err := c.source.GetRole(userID)
+	if err != nil {
+		return "", err
+	}
+
+	c.mu.Lock()
+	c.entries[userID] = roleEntry{role: pkg.Role(role), expiresAt: now.Add(c.ttl)}
+	c.mu.Unlock()
+	return pkg.Role(role), nil
+}
+
+// Invalidate 역할 변경 직후 캐시 제거
+func (c *RoleCache) Invalidate(userID uint) {
+	c.mu.Lock()
+	delete(c.entries, userID)
+	c.mu.Unlock()
+}
@@ -4,6 +4,7 @@ import (
 	"log"
 	"os"
 	"strconv"
+	"strings"
 )
 
 type Config struct {
@@ -12,7 +13,17 @@ type Config struct {
 	Redis       RedisConfig
 	JWT         JWTConfig
 	OpenAI      OpenAIConfig
-	Google      GoogleOAuthConfig
+	Google      OAuthProviderConfig
+	Kakao       OAuthProviderConfig
+	Line        OAuthProviderConfig
+	Apple       AppleOAuthConfig
+	OAuth       OAuthConfig
+	Mail        MailConfig
+	Account     AccountConfig
+	RateLimit   RateLimitConfig
+	Review      ReviewConfig
+	DailySet    DailySetConfig
+	Cache       CacheConfig
 	VoiceVox    VoiceVoxConfig
 	NCP_Storage NCloudStorageConfig
 }
@@ -28,10 +39,75 @@ type VoiceVoxConfig struct {
 	VoiceVoxURL string
 }
 
-type GoogleOAuthConfig struct {
+// OAuthProviderConfig OAuth 공급자 설정
+// AuthURL/TokenURL/UserInfoURL은 비워 두면 공급자 기본값을 사용합니다. (로컬 fake OAuth 서버 테스트용)
+type OAuthProviderConfig struct {
 	ClientID     string
 	ClientSecret string
 	RedirectURL  string
+	AuthURL      string
+	TokenURL     string
+	UserInfoURL  string
+}
+
+// AppleOAuthConfig Sign in with Apple 설정 (client_secret 대신 .p8 키 사용)
+type AppleOAuthConfig struct {
+	OAuthProviderConfig
+	TeamID     string
+	KeyID      string
+	PrivateKey string
+	Issuer     string
+}
+
+// OAuthConfig OAuth 공통 설정 (state 서명, redirect 허용 목록)
+type OAuthConfig struct {
+	StateSecret      string
+	MobileRedirect   string
+	AllowedRedirects []string
+}
+
+// MailConfig 메일 발송 설정 (SMTP_HOST가 비어 있으면 로그로만 출력)
+// VerifyURL/ResetURL에는 token 쿼리가 붙어 메일로 전송됩니다.
+type MailConfig struct {
+	SMTPHost     string
+	SMTPPort     string
+	SMTPUsername string
+	SMTPPassword string
+	From         string
+	VerifyURL    string
+	ResetURL     string
+}
+
+// AccountConfig 계정 관리 설정
+type AccountConfig struct {
+	DeletionGraceDays int      // 탈퇴 요청 후 영구 삭제까지 유예 기간
+	GuestTTLDays      int      // 사용 기록이 없는 게스트 계정을 삭제하기까지의 기간
+	DefaultTimezone   string   // 설정이 없는 사용자의 "오늘" 기준 타임존
+	AdminEmails       []string // 기동 시 admin 역할을 부여할 이메일 (인증된 이메일만)
+}
+
+// RateLimitConfig 라우트 그룹별 분당 최대 요청 수 (0이면 제한 없음)
+type RateLimitConfig struct {
+	AuthPerMinute      int
+	ChatPerMinute      int
+	SentencesPerMinute int
+	AudioPerMinute     int
+}
+
+// ReviewConfig 복습(간격 반복) 설정
+type ReviewConfig struct {
+	PerDailySet int // 오늘의 문장에 섞을 최대 복습 문장 수 (0이면 섞지 않음)
+}
+
+// DailySetConfig 오늘의 문장 세트 설정
+type DailySetConfig struct {
+	PregenerateActiveDays int // 최근 이 기간 안에 사용한 사용자의 내일 세트를 미리 생성 (0이면 미리 생성하지 않음)
+}
+
+// CacheConfig 읽기 캐시 설정 (Redis 미설정 시 캐시하지 않음)
+type CacheConfig struct {
+	SentenceTTLMinutes int // 문장/상세 정보 캐시 유지 시간
+	TodayTTLMinutes    int // 오늘의 문장 응답 캐시 유지 시간 (0이면 응답을 캐시하지 않음)
 }
 
 type OpenAIConfig struct {
@@ -62,6 +138,8 @@ type RedisConfig struct {
 
 type JWTConfig struct {
 	Secret          string
+	KeyID           string            // 현재 서명 키 ID (kid 헤더)
+	PreviousKeys    map[string]string // 검증 전용 이전 키 (kid -> secret)
 	ExpirationHours int
 }
 
@@ -87,16 +165,59 @@ func Load() *Config {
 		},
 		JWT: JWTConfig{
 			Secret:          getEnv("JWT_SECRET", "your-super-secret-key-change-in-production"),
+			KeyID:           getEnv("JWT_KEY_ID", "v1"),
+			PreviousKeys:    getEnvAsKeyMap("JWT_PREVIOUS_KEYS"),
 			ExpirationHours: getEnvAsInt("JWT_EXPIRATION_HOURS", 24),
 		},
 		OpenAI: OpenAIConfig{
 			APIKey: getEnv("OPEN_AI_API_KEY", ""),
 			Model:  getEnv("OPENAI_MODEL", "gpt-4o-mini"),
 		},
-		Google: GoogleOAuthConfig{
-			ClientID:     getEnv("GOOGLE_CLIENT_ID", ""),
-			ClientSecret: getEnv("GOOGLE_CLIENT_SECRET", ""),
-			RedirectURL:  getEnv("GOOGLE_REDIRECT_URL", "http://localhost:30001/api/auth/google/callback"),
+		Google: getOAuthProviderConfig("GOOGLE", "http://localhost:30001/api/auth/google/callback"),
+		Kakao:  getOAuthProviderConfig("KAKAO", "http://localhost:30001/api/auth/kakao/callback"),
+		Line:   getOAuthProviderConfig("LINE", "http://localhost:30001/api/auth/line/callback"),
+		Apple: AppleOAuthConfig{
+			OAuthProviderConfig: getOAuthProviderConfig("APPLE", "http://localhost:30001/api/auth/apple/callback"),
+			TeamID:              getEnv("APPLE_TEAM_ID", ""),
+			KeyID:               getEnv("APPLE_KEY_ID", ""),
+			PrivateKey:          strings.ReplaceAll(getEnv("APPLE_PRIVATE_KEY", ""), `\n`, "\n"),
+			Issuer:              getEnv("APPLE_ISSUER", ""),
+		},
+		OAuth: OAuthConfig{
+			StateSecret:      getEnv("OAUTH_STATE_SECRET", ""),
+			MobileRedirect:   getEnv("OAUTH_MOBILE_REDIRECT", "jptaku://auth/callback"),
+			AllowedRedirects: getEnvAsList("OAUTH_ALLOWED_REDIRECTS"),
+		},
+		Mail: MailConfig{
+			SMTPHost:     getEnv("SMTP_HOST", ""),
+			SMTPPort:     getEnv("SMTP_PORT", "1025"),
+			SMTPUsername: getEnv("SMTP_USERNAME", ""),
+			SMTPPassword: getEnv("SMTP_PASSWORD", ""),
+			From:         getEnv("MAIL_FROM", "jptaku <no-reply@jptaku.com>"),
+			VerifyURL:    getEnv("MAIL_VERIFY_URL", "http://localhost:30001/api/auth/email/verify"),
+			ResetURL:     getEnv("MAIL_RESET_URL", "jptaku://auth/reset-password"),
+		},
+		Account: AccountConfig{
+			DeletionGraceDays: getEnvAsInt("ACCOUNT_DELETION_GRACE_DAYS", 14),
+			GuestTTLDays:      getEnvAsInt("GUEST_TTL_DAYS", 30),
+			DefaultTimezone:   getEnv("DEFAULT_TIMEZONE", "Asia/Seoul"),
+			AdminEmails:       getEnvAsList("ADMIN_EMAILS"),
+		},
+		RateLimit: RateLimitConfig{
+			AuthPerMinute:      getEnvAsInt("RATE_LIMIT_AUTH_PER_MINUTE", 20),
+			ChatPerMinute:      getEnvAsInt("RATE_LIMIT_CHAT_PER_MINUTE", 30),
+			SentencesPerMinute: getEnvAsInt("RATE_LIMIT_SENTENCES_PER_MINUTE", 60),
+			AudioPerMinute:     getEnvAsInt("RATE_LIMIT_AUDIO_PER_MINUTE", 120),
+		},
+		Review: ReviewConfig{
+			PerDailySet: getEnvAsInt("REVIEW_PER_DAILY_SET", 2),
+		},
+		DailySet: DailySetConfig{
+			PregenerateActiveDays: getEnvAsInt("DAILY_SET_PREGENERATE_ACTIVE_DAYS", 7),
+		},
+		Cache: CacheConfig{
+			SentenceTTLMinutes: getEnvAsInt("CACHE_SENTENCE_TTL_MINUTES", 1440),
+			TodayTTLMinutes:    getEnvAsInt("CACHE_TODAY_TTL_MINUTES", 10),
 		},
 		VoiceVox: VoiceVoxConfig{
 			VoiceVoxURL: getEnv("VOICEVOX_URL", "http://localhost:50021"),
@@ -128,3 +249,45 @@ func getEnvAsInt(key string, defaultValue int) int {
 	}
 	return defaultValue
 }
+
+// getOAuthProviderConfig {PREFIX}_CLIENT_ID 등 공급자별 환경 변수 로드
+func getOAuthProviderConfig(prefix, defaultRedirect string) OAuthProviderConfig {
+	return OAuthProviderConfig{
+		ClientID:     getEnv(prefix+"_CLIENT_ID", ""),
+		ClientSecret: getEnv(prefix+"_CLIENT_SECRET", ""),
+		RedirectURL:  getEnv(prefix+"_REDIRECT_URL", defaultRedirect),
+		AuthURL:      getEnv(prefix+"_AUTH_URL", ""),
+		TokenURL:     getEnv(prefix+"_TOKEN_URL", ""),
+		UserInfoURL:  getEnv(prefix+"_USERINFO_URL", ""),
+	}
+}
+
+// getEnvAsList 콤마로 구분된 환경 변수를 슬라이스로 변환
+func getEnvAsList(key string) []string {
+	var result []string
+	for _, item := range strings.Split(os.Getenv(key), ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+// getEnvAsKeyMap "kid1:secret1,kid2:secret2" 형식의 환경 변수를 map으로 변환
+func getEnvAsKeyMap(key string) map[string]string {
+	result := make(map[string]string)
+	value := os.Getenv(key)
+	if value == "" {
+		return result
+	}
+
+	for _, pair := range strings.Split(value, ",") {
+		kid, secret, ok := strings.Cut(strings.TrimSpace(pair), ":")
+		if !ok || kid == "" || secret == "" {
+			log.Printf("Warning: Invalid key entry in %s, skipping", key)
+			continue
+		}
+		result[kid] = secret
+	}
+	return result
+}
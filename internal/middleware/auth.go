@@ -23,7 +23,7 @@ func AuthMiddleware(jwtManager *pkg.JWTManager) gin.HandlerFunc {
 			return
 		}
 
-		claims, err := jwtManager.ValidateToken(parts[1])
+		claims, err := jwtManager.ValidateAccessToken(parts[1])
 		if err != nil {
 			pkg.UnauthorizedResponse(c, "Invalid or expired token")
 			c.Abort()
@@ -33,6 +33,8 @@ func AuthMiddleware(jwtManager *pkg.JWTManager) gin.HandlerFunc {
 		// 유저 정보를 context에 저장
 		c.Set("userID", claims.UserID)
 		c.Set("email", claims.Email)
+		c.Set("familyID", claims.FamilyID)
+		c.Set("role", claims.Role)
 
 		c.Next()
 	}
@@ -46,3 +48,20 @@ func GetUserID(c *gin.Context) uint {
 	}
 	return userID.(uint)
 }
+
+// GetFamilyID는 context에서 현재 로그인 세션의 refresh token family를 가져옵니다.
+func GetFamilyID(c *gin.Context) string {
+	familyID, _ := c.Get("familyID")
+	id, _ := familyID.(string)
+	return id
+}
+
+// GetRole는 context에서 유저 역할을 가져옵니다.
+func GetRole(c *gin.Context) pkg.Role {
+	role, _ := c.Get("role")
+	r, _ := role.(pkg.Role)
+	if r == "" {
+		return pkg.RoleUser
+	}
+	return r
+}
@@ -0,0 +1,78 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jptaku/server/internal/pkg"
+)
+
+// RateLimiter 요청 제한 판정기 (cache.RateLimiter, cache.MemoryRateLimiter)
+type RateLimiter interface {
+	Allow(ctx context.Context, policy pkg.RateLimitPolicy, subject string) (*pkg.RateLimitResult, error)
+}
+
+// RateLimit 라우트 그룹별 요청 제한
+// 유효한 access token이 있으면 사용자별, 없으면 IP별로 제한합니다.
+// AuthMiddleware보다 먼저 실행되도록 그룹에 등록하므로 토큰을 직접 확인합니다.
+// 응답에는 RateLimit-Limit/Remaining/Reset 헤더가, 제한에 걸리면 429와 Retry-After가 붙습니다.
+func RateLimit(limiter RateLimiter, policy pkg.RateLimitPolicy, jwtManager *pkg.JWTManager) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if limiter == nil || policy.Limit <= 0 {
+			c.Next()
+			return
+		}
+
+		result, err := limiter.Allow(c.Request.Context(), policy, rateLimitSubject(c, jwtManager))
+		if err != nil {
+			// 판정할 수 없으면 요청을 막지 않습니다.
+			log.Printf("Rate limit check failed: policy=%s err=%v", policy.Name, err)
+			c.Next()
+			return
+		}
+
+		reset := strconv.Itoa(ceilSeconds(result.Reset))
+		c.Header("RateLimit-Policy", policy.String())
+		c.Header("RateLimit-Limit", strconv.Itoa(result.Limit))
+		c.Header("RateLimit-Remaining", strconv.Itoa(result.Remaining))
+		c.Header("RateLimit-Reset", reset)
+
+		if !result.Allowed {
+			c.Header("Retry-After", reset)
+			pkg.ErrorResponse(c, http.StatusTooManyRequests, "요청이 너무 많습니다. 잠시 후 다시 시도해주세요")
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// rateLimitSubject 제한 대상 (user:{id} 또는 ip:{addr})
+func rateLimitSubject(c *gin.Context, jwtManager *pkg.JWTManager) string {
+	if jwtManager != nil {
+		parts := strings.SplitN(c.GetHeader("Authorization"), " ", 2)
+		if len(parts) == 2 && strings.ToLower(parts[0]) == "bearer" {
+			if claims, err := jwtManager.ValidateAccessToken(parts[1]); err == nil {
+				return fmt.Sprintf("user:%d", claims.UserID)
+			}
+		}
+	}
+	return "ip:" + c.ClientIP()
+}
+
+// ceilSeconds 헤더용 초 단위 (최소 1초)
+func ceilSeconds(d time.Duration) int {
+	s := int(math.Ceil(d.Seconds()))
+	if s < 1 {
+		return 1
+	}
+	return s
+}
@@ -0,0 +1,124 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/gin-gonic/gin"
+	"github.com/jptaku/server/internal/cache"
+	"github.com/jptaku/server/internal/pkg"
+	"github.com/redis/go-redis/v9"
+)
+
+// newRateLimitedRouter 정책 하나가 걸린 테스트 라우터
+func newRateLimitedRouter(limiter RateLimiter, policy pkg.RateLimitPolicy, jwtManager *pkg.JWTManager) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.GET("/limited", RateLimit(limiter, policy, jwtManager), func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+	return r
+}
+
+func doRequest(r *gin.Engine, token string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, "/limited", nil)
+	req.RemoteAddr = "10.0.0.1:1234"
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	return w
+}
+
+func TestRateLimitHeaders(t *testing.T) {
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { client.Close() })
+
+	policy := pkg.RateLimitPolicy{Name: "test", Limit: 2, Window: time.Minute}
+	r := newRateLimitedRouter(cache.NewRateLimiter(client), policy, nil)
+
+	steps := []struct {
+		wantStatus    int
+		wantRemaining string
+	}{
+		{http.StatusOK, "1"},
+		{http.StatusOK, "0"},
+		{http.StatusTooManyRequests, "0"},
+	}
+	for i, step := range steps {
+		w := doRequest(r, "")
+		if w.Code != step.wantStatus {
+			t.Fatalf("#%d: status = %d, want %d", i, w.Code, step.wantStatus)
+		}
+
+		headers := map[string]string{
+			"RateLimit-Policy":    "2;w=60",
+			"RateLimit-Limit":     "2",
+			"RateLimit-Remaining": step.wantRemaining,
+			"RateLimit-Reset":     "60",
+		}
+		for name, want := range headers {
+			if got := w.Header().Get(name); got != want {
+				t.Errorf("#%d: %s = %q, want %q", i, name, got, want)
+			}
+		}
+
+		retryAfter := w.Header().Get("Retry-After")
+		if step.wantStatus == http.StatusTooManyRequests && retryAfter != "60" {
+			t.Errorf("#%d: Retry-After = %q, want %q", i, retryAfter, "60")
+		}
+		if step.wantStatus == http.StatusOK && retryAfter != "" {
+			t.Errorf("#%d: Retry-After = %q on allowed request", i, retryAfter)
+		}
+	}
+
+	// 유효한 토큰이 있으면 IP가 같아도 사용자별로 셉니다.
+	jwtManager := pkg.NewJWTManager("test", "secret", 1)
+	token, err := jwtManager.GenerateToken(1, "user@example.com", pkg.RoleUser, "family")
+	if err != nil {
+		t.Fatalf("GenerateToken() error = %v", err)
+	}
+	r = newRateLimitedRouter(cache.NewRateLimiter(client), policy, jwtManager)
+	if w := doRequest(r, token); w.Code != http.StatusOK {
+		t.Errorf("authenticated request status = %d, want %d", w.Code, http.StatusOK)
+	}
+}
+
+type failingRateLimiter struct{}
+
+func (failingRateLimiter) Allow(context.Context, pkg.RateLimitPolicy, string) (*pkg.RateLimitResult, error) {
+	return nil, errors.New("limiter unavailable")
+}
+
+func TestRateLimitPassesThrough(t *testing.T) {
+	tests := []struct {
+		name    string
+		limiter RateLimiter
+		policy  pkg.RateLimitPolicy
+	}{
+		{"nil limiter", nil, pkg.RateLimitPolicy{Name: "test", Limit: 1, Window: time.Minute}},
+		{"disabled policy", cache.NewMemoryRateLimiter(), pkg.RateLimitPolicy{Name: "test", Limit: 0, Window: time.Minute}},
+		{"limiter error", failingRateLimiter{}, pkg.RateLimitPolicy{Name: "test", Limit: 1, Window: time.Minute}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newRateLimitedRouter(tt.limiter, tt.policy, nil)
+			for i := 0; i < 3; i++ {
+				w := doRequest(r, "")
+				if w.Code != http.StatusOK {
+					t.Fatalf("#%d: status = %d, want %d", i, w.Code, http.StatusOK)
+				}
+				if got := w.Header().Get("RateLimit-Limit"); got != "" {
+					t.Errorf("#%d: RateLimit-Limit = %q, want no header", i, got)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package middleware
+
+import (
+	"log"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jptaku/server/internal/pkg"
+)
+
+// RequireRole 지정한 역할 중 하나가 있어야 통과 (AuthMiddleware 다음에 사용)
+func RequireRole(roles ...pkg.Role) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		role := GetRole(c)
+		for _, r := range roles {
+			if role == r {
+				c.Next()
+				return
+			}
+		}
+
+		pkg.ForbiddenResponse(c, "접근 권한이 없습니다")
+		c.Abort()
+	}
+}
+
+// RequirePermission 역할에 해당 권한이 있어야 통과 (AuthMiddleware 다음에 사용)
+func RequirePermission(permission pkg.Permission) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !GetRole(c).Can(permission) {
+			pkg.ForbiddenResponse(c, "접근 권한이 없습니다")
+			c.Abort()
+			return
+		}
+		c.Next()
+	}
+}
+
+// RoleLookup 사용자 현재 역할 조회 (cache.RoleCache)
+type RoleLookup interface {
+	CurrentRole(userID uint) (pkg.Role, error)
+}
+
+// CurrentRole 토큰의 role 클레임을 DB의 현재 역할로 교체 (AuthMiddleware 다음, RequireRole 앞에 사용)
+// 강등된 사용자가 Access Token 만료 전까지 권한을 유지하지 않도록 관리 라우트에 적용합니다.
+// 사용자가 없으면 역할이 비어 이후 권한 확인에서 거부됩니다.
+func CurrentRole(lookup RoleLookup) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		role, err := lookup.CurrentRole(GetUserID(c))
+		if err != nil {
+			log.Printf("Role lookup failed: user=%d err=%v", GetUserID(c), err)
+			pkg.InternalServerErrorResponse(c, "권한을 확인할 수 없습니다")
+			c.Abort()
+			return
+		}
+		c.Set("role", role)
+		c.Next()
+	}
+}
@@ -0,0 +1,49 @@
+package model
+
+import "time"
+
+// BookmarkFolder 사용자가 만든 즐겨찾기 폴더
+type BookmarkFolder struct {
+	ID        uint      `gorm:"primaryKey" json:"id"`
+	UserID    uint      `gorm:"not null;uniqueIndex:idx_bookmark_folders_user_name" json:"user_id"`
+	Name      string    `gorm:"size:50;not null;uniqueIndex:idx_bookmark_folders_user_name" json:"name"`
+	Position  int       `gorm:"not null;default:0" json:"position"` // 폴더 목록 정렬 순서
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	// Relations
+	User *User `gorm:"foreignKey:UserID" json:"-"`
+}
+
+func (BookmarkFolder) TableName() string {
+	return "bookmark_folders"
+}
+
+// SentenceBookmark 즐겨찾기한 문장
+// 폴더 없이(FolderID nil) 저장할 수 있고, 같은 폴더 안에서 Position 순으로 정렬됩니다.
+type SentenceBookmark struct {
+	ID         uint      `gorm:"primaryKey" json:"id"`
+	UserID     uint      `gorm:"not null;uniqueIndex:idx_sentence_bookmarks_user_sentence;index:idx_sentence_bookmarks_user_folder,priority:1" json:"user_id"`
+	SentenceID uint      `gorm:"not null;uniqueIndex:idx_sentence_bookmarks_user_sentence" json:"sentence_id"`
+	FolderID   *uint     `gorm:"index:idx_sentence_bookmarks_user_folder,priority:2" json:"folder_id"`
+	Note       string    `gorm:"size:500" json:"note"`
+	Position   int       `gorm:"not null;default:0" json:"position"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+
+	// Relations
+	User     *User           `gorm:"foreignKey:UserID" json:"-"`
+	Folder   *BookmarkFolder `gorm:"foreignKey:FolderID;constraint:OnDelete:SET NULL" json:"-"`
+	Sentence *Sentence       `gorm:"foreignKey:SentenceID" json:"sentence,omitempty"`
+}
+
+func (SentenceBookmark) TableName() string {
+	return "sentence_bookmarks"
+}
+
+// BookmarkFilter 즐겨찾기 목록 조회 조건 (nil이면 조건 없음)
+type BookmarkFilter struct {
+	FolderID    *uint // 0이면 폴더 없는 즐겨찾기만
+	SubCategory *int
+	Level       *int
+}
@@ -6,9 +6,10 @@ import (
 
 type DailySentenceSet struct {
 	ID          uint      `gorm:"primaryKey" json:"id"`
-	UserID      uint      `gorm:"index;not null" json:"user_id"`
-	Date        time.Time `gorm:"type:date;index;not null" json:"date"`          // 날짜
-	SentenceIDs []uint    `gorm:"type:jsonb;serializer:json" json:"sentence_ids"` // 5개 문장 ID
+	UserID      uint      `gorm:"uniqueIndex:idx_daily_sets_user_date;not null" json:"user_id"`
+	Date        time.Time `gorm:"type:date;uniqueIndex:idx_daily_sets_user_date;index;not null" json:"date"` // 날짜 (사용자당 하루 하나)
+	SentenceIDs []uint    `gorm:"type:jsonb;serializer:json" json:"sentence_ids"`                            // 5개 문장 ID
+	ReviewIDs   []uint    `gorm:"type:jsonb;serializer:json" json:"review_ids"`                              // 그중 복습으로 섞인 문장 ID
 	CreatedAt   time.Time `json:"created_at"`
 
 	// Relations
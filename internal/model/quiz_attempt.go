@@ -0,0 +1,61 @@
+package model
+
+import "time"
+
+// QuizAttempt 퀴즈 제출 기록 (제출할 때마다 한 행)
+// 모든 유형의 결과는 Parts에 남고, 빈칸 채우기/문장 배열은 통계용으로 컬럼에도 남습니다.
+// 문장 배열 답안은 사용자별로 섞인 화면 인덱스가 아니라 원래 조각 인덱스로 저장합니다.
+type QuizAttempt struct {
+	ID               uint              `gorm:"primaryKey" json:"id"`
+	UserID           uint              `gorm:"not null;index:idx_quiz_attempts_user_date,priority:1;index:idx_quiz_attempts_user_sentence,priority:1" json:"user_id"`
+	SentenceID       uint              `gorm:"not null;index:idx_quiz_attempts_user_sentence,priority:2;index:idx_quiz_attempts_sentence" json:"sentence_id"`
+	DailySetID       uint              `gorm:"index" json:"daily_set_id,omitempty"`
+	AttemptNo        int               `gorm:"not null;default:1" json:"attempt_no"`              // 같은 문장에서 몇 번째 시도인지 (1부터)
+	FillBlankAnswer  string            `gorm:"size:255" json:"fill_blank_answer"`                 // 제출한 빈칸 채우기 답
+	OrderingAnswer   []int             `gorm:"type:jsonb;serializer:json" json:"ordering_answer"` // 제출한 문장 배열 답 (원래 조각 인덱스)
+	FillBlankCorrect bool              `gorm:"not null;default:false" json:"fill_blank_correct"`  // 빈칸 채우기 정답 여부
+	OrderingCorrect  bool              `gorm:"not null;default:false" json:"ordering_correct"`    // 문장 배열 정답 여부
+	Complete         bool              `gorm:"not null;default:false" json:"complete"`            // 문장에 있는 모든 유형을 제출했는지
+	AllCorrect       bool              `gorm:"not null;default:false" json:"all_correct"`         // 모든 유형을 제출해 모두 맞혔는지
+	Parts            []QuizAttemptPart `gorm:"type:jsonb;serializer:json" json:"parts"`           // 유형별 답과 정답 여부
+	TimeSpentSeconds int               `gorm:"not null;default:0" json:"time_spent_seconds"`      // 풀이 소요 시간 (클라이언트 보고값)
+	AttemptedAt      time.Time         `gorm:"not null;index:idx_quiz_attempts_user_date,priority:2,sort:desc" json:"attempted_at"`
+
+	// Relations
+	User     *User     `gorm:"foreignKey:UserID" json:"-"`
+	Sentence *Sentence `gorm:"foreignKey:SentenceID" json:"sentence,omitempty"`
+}
+
+// QuizAttemptPart 퀴즈 시도의 유형별 결과
+type QuizAttemptPart struct {
+	Type    string     `json:"type"`            // pkg.QuizType
+	Answer  QuizAnswer `json:"answer"`          // 제출한 답 (문장 배열은 원래 조각 인덱스)
+	Correct bool       `json:"correct"`         // 정답 여부 (표기만 다른 답 포함)
+	Match   string     `json:"match,omitempty"` // pkg.AnswerMatch (exact, acceptable, wrong)
+}
+
+func (QuizAttempt) TableName() string {
+	return "quiz_attempts"
+}
+
+// QuizSentenceStats 문장별 퀴즈 난이도 통계 (콘텐츠 조정용)
+type QuizSentenceStats struct {
+	SentenceID             uint               `json:"sentence_id"`
+	Attempts               int64              `json:"attempts"`                                // 전체 시도 수
+	Users                  int64              `json:"users"`                                   // 시도한 사용자 수
+	FirstAttempts          int64              `json:"first_attempts"`                          // 첫 시도 수
+	FirstTryCorrectRate    float64            `json:"first_try_correct_rate"`                  // 모든 유형을 처음 제출한 시도에 모두 맞힌 비율 (0~1)
+	FirstTryFillBlankRate  float64            `json:"first_try_fill_blank_rate"`               // 첫 시도 빈칸 채우기 정답률
+	FirstTryOrderingRate   float64            `json:"first_try_ordering_rate"`                 // 첫 시도 문장 배열 정답률
+	FirstTryTypeRates      map[string]float64 `json:"first_try_type_rates" gorm:"-"`           // 유형별 첫 시도 정답률 (그 유형을 처음 제출한 시도 기준)
+	CommonWrongOption      string             `json:"common_wrong_option,omitempty"`           // 가장 많이 고른 빈칸 채우기 오답 보기
+	CommonWrongOptionCount int64              `json:"common_wrong_option_count,omitempty"`     // 가장 많이 고른 오답 보기를 고른 횟수
+	CommonWrongOptions     []QuizWrongOption  `json:"common_wrong_options,omitempty" gorm:"-"` // 보기 고르기 유형(빈칸 채우기, 뜻 고르기, 듣기)별 가장 많이 고른 오답
+}
+
+// QuizWrongOption 유형별로 가장 많이 고른 오답 보기
+type QuizWrongOption struct {
+	Type   string `json:"type"` // pkg.QuizType
+	Option string `json:"option"`
+	Count  int64  `json:"count"`
+}
@@ -0,0 +1,30 @@
+package model
+
+import "time"
+
+// SentenceReview 사용자별 문장 복습 일정 (SM-2)
+// 문장을 처음 암기 완료하면 생성되고, 복습 차례가 된 뒤의 퀴즈/복습 평가로 간격과 다음 복습 시각이 갱신됩니다.
+// 차례가 되기 전에 다시 푼 것은 연습으로만 기록합니다.
+type SentenceReview struct {
+	ID              uint       `gorm:"primaryKey" json:"id"`
+	UserID          uint       `gorm:"not null;uniqueIndex:idx_sentence_reviews_user_sentence;index:idx_sentence_reviews_user_due,priority:1" json:"user_id"`
+	SentenceID      uint       `gorm:"not null;uniqueIndex:idx_sentence_reviews_user_sentence" json:"sentence_id"`
+	EaseFactor      float64    `gorm:"not null;default:2.5" json:"ease_factor"`
+	IntervalDays    int        `gorm:"not null;default:0" json:"interval_days"`
+	Repetitions     int        `gorm:"not null;default:0" json:"repetitions"` // 연속으로 기억한 횟수
+	Lapses          int        `gorm:"not null;default:0" json:"lapses"`      // 잊어버린 횟수
+	DueAt           time.Time  `gorm:"not null;index:idx_sentence_reviews_user_due,priority:2" json:"due_at"`
+	LastReviewedAt  *time.Time `json:"last_reviewed_at,omitempty"`
+	PracticeCount   int        `gorm:"not null;default:0" json:"practice_count"` // 복습 차례 전에 다시 푼 횟수 (일정에 반영 안 됨)
+	LastPracticedAt *time.Time `json:"last_practiced_at,omitempty"`
+	CreatedAt       time.Time  `json:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at"`
+
+	// Relations
+	User     *User     `gorm:"foreignKey:UserID" json:"-"`
+	Sentence *Sentence `gorm:"foreignKey:SentenceID" json:"sentence,omitempty"`
+}
+
+func (SentenceReview) TableName() string {
+	return "sentence_reviews"
+}
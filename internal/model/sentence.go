@@ -3,18 +3,20 @@ package model
 import (
 	"time"
 
+	"github.com/jptaku/server/internal/pkg"
 	"gorm.io/gorm"
 )
 
 type Sentence struct {
-	ID          uint           `gorm:"primaryKey" json:"id"`
+	ID          uint           `gorm:"primaryKey;index:idx_sentences_sub_category_id,priority:2" json:"id"`
 	SentenceKey string         `gorm:"size:20;index;not null" json:"sentence_key"`   // 조합 키 (예: "101_0") - SubCategory_Level
 	JP          string         `gorm:"type:text;not null" json:"jp"`                 // 일본어 문장
 	KR          string         `gorm:"type:text;not null" json:"kr"`                 // 한국어 번역
 	Romaji      string         `gorm:"type:text" json:"romaji,omitempty"`            // 로마지
 	Level       int            `gorm:"default:1;index" json:"level"`                 // 난이도 0~3
-	SubCategory int            `gorm:"default:101;index" json:"sub_category"`        // 단일 SubCategory 값
+	SubCategory int            `gorm:"default:101;index;index:idx_sentences_sub_category_id,priority:1" json:"sub_category"` // 단일 SubCategory 값
 	AudioURL    string         `gorm:"size:500" json:"audio_url,omitempty"`
+	SearchText  string         `gorm:"type:text;not null;default:''" json:"-"` // 검색용 정규화 텍스트 (SetSearchText)
 	CreatedAt   time.Time      `json:"created_at"`
 	UpdatedAt   time.Time      `json:"updated_at"`
 	DeletedAt   gorm.DeletedAt `gorm:"index" json:"-"`
@@ -36,10 +38,15 @@ type Word struct {
 	PartOf   string `json:"part_of"` // 품사
 }
 
-// Quiz 확인하기 퀴즈
+// Quiz 확인하기 퀴즈 (pkg.QuizType 유형별 문제, 없는 유형은 nil)
+// 빈칸 채우기/문장 배열은 문장 생성 시 함께 만들고, 나머지 유형은 문장과 단어 풀이로 채웁니다 (sentence.CompleteQuiz).
 type Quiz struct {
 	FillBlank *QuizFillBlank `json:"fill_blank,omitempty"`
 	Ordering  *QuizOrdering  `json:"ordering,omitempty"`
+	Meaning   *QuizChoice    `json:"meaning,omitempty"`   // 한국어 뜻 고르기
+	Listening *QuizChoice    `json:"listening,omitempty"` // 음성(Sentence.AudioURL)을 듣고 문장 고르기
+	Dictation *QuizDictation `json:"dictation,omitempty"` // 음성을 듣고 받아쓰기
+	Reading   *QuizReading   `json:"reading,omitempty"`   // 단어 읽기
 }
 
 // QuizFillBlank 빈칸 채우기
@@ -55,6 +62,53 @@ type QuizOrdering struct {
 	CorrectOrder []int    `json:"correct_order"`
 }
 
+// QuizChoice 보기 중 정답 고르기
+type QuizChoice struct {
+	Options []string `json:"options"`
+	Answer  string   `json:"answer"`
+}
+
+// QuizDictation 받아쓰기
+type QuizDictation struct {
+	Answer string `json:"answer"` // 들려주는 문장 (Sentence.JP)
+}
+
+// QuizReading 단어 읽기 (한자가 들어간 단어의 읽는 법을 가나로 입력)
+type QuizReading struct {
+	Items []QuizReadingItem `json:"items"`
+}
+
+// QuizReadingItem 단어 읽기 문항
+type QuizReadingItem struct {
+	Word    string `json:"word"`
+	Reading string `json:"reading"`
+}
+
+// QuizAnswer 퀴즈 유형별 답 (유형에 따라 한 필드만 사용)
+type QuizAnswer struct {
+	Text  string   `json:"text,omitempty"`  // 빈칸 채우기, 뜻 고르기, 듣기, 받아쓰기
+	Texts []string `json:"texts,omitempty"` // 단어 읽기 (문항 순서)
+	Order []int    `json:"order,omitempty"` // 문장 배열 (조각 인덱스)
+}
+
+// SetSearchText 문장, 번역, 로마지, 단어 풀이로 검색용 텍스트 생성 (pkg.NormalizeSearchText 기준)
+func (s *Sentence) SetSearchText(words []Word) {
+	fields := []string{s.JP, s.KR, s.Romaji}
+	for _, w := range words {
+		fields = append(fields, w.Japanese, w.Reading, w.Meaning)
+	}
+	s.SearchText = pkg.BuildSearchText(fields...)
+}
+
+// SentenceSearchFilter 문장 검색 조건
+type SentenceSearchFilter struct {
+	Query          string    // 정규화된 검색어
+	Level          *int      // nil이면 전체
+	SubCategory    *int      // nil이면 전체
+	AssignedUserID uint      // 0이 아니면 이 사용자의 데일리 세트에 나온 문장만
+	AssignedUntil  time.Time // AssignedUserID가 있을 때, 이 날짜까지의 세트만 (미리 생성된 세트 제외)
+}
+
 func (Sentence) TableName() string {
 	return "sentences"
 }
@@ -0,0 +1,111 @@
+package model
+
+import (
+	"math/rand/v2"
+	"strings"
+	"unicode"
+)
+
+const (
+	quizChoiceCount     = 4 // 뜻 고르기/듣기 보기 수 (정답 포함)
+	quizReadingMaxItems = 3 // 단어 읽기 최대 문항 수
+)
+
+// CompleteQuiz 퀴즈에 없는 유형(뜻 고르기, 듣기, 받아쓰기, 단어 읽기)을 문장과 단어 풀이로 채움
+// 뜻/듣기 오답 보기는 pool의 다른 문장에서 고르고, 음성이 없는 문장에는 듣기/받아쓰기를 만들지 않습니다.
+// 이미 있는 유형은 건드리지 않으며, 채운 유형이 있으면 true를 반환합니다.
+func (d *SentenceDetail) CompleteQuiz(sentence *Sentence, pool []Sentence) bool {
+	quiz := d.Quiz
+	if quiz == nil {
+		quiz = &Quiz{}
+	}
+	rng := rand.New(rand.NewPCG(uint64(sentence.ID), uint64(len(pool))))
+	changed := false
+
+	if quiz.Meaning == nil {
+		if options := choiceOptions(sentence.ID, sentence.KR, pool, func(s Sentence) string { return s.KR }, rng); options != nil {
+			quiz.Meaning = &QuizChoice{Options: options, Answer: sentence.KR}
+			changed = true
+		}
+	}
+
+	if sentence.AudioURL != "" {
+		if quiz.Listening == nil {
+			if options := choiceOptions(sentence.ID, sentence.JP, pool, func(s Sentence) string { return s.JP }, rng); options != nil {
+				quiz.Listening = &QuizChoice{Options: options, Answer: sentence.JP}
+				changed = true
+			}
+		}
+		if quiz.Dictation == nil && sentence.JP != "" {
+			quiz.Dictation = &QuizDictation{Answer: sentence.JP}
+			changed = true
+		}
+	}
+
+	if quiz.Reading == nil {
+		if items := readingItems(d.Words); len(items) > 0 {
+			quiz.Reading = &QuizReading{Items: items}
+			changed = true
+		}
+	}
+
+	if changed {
+		d.Quiz = quiz
+	}
+	return changed
+}
+
+// choiceOptions 정답과 pool에서 고른 오답으로 보기 구성 (오답을 하나도 못 고르면 nil)
+func choiceOptions(sentenceID uint, answer string, pool []Sentence, field func(Sentence) string, rng *rand.Rand) []string {
+	if answer == "" {
+		return nil
+	}
+
+	seen := map[string]bool{answer: true}
+	options := []string{answer}
+	for _, i := range rng.Perm(len(pool)) {
+		if len(options) == quizChoiceCount {
+			break
+		}
+		candidate := pool[i]
+		value := field(candidate)
+		if candidate.ID == sentenceID || value == "" || seen[value] {
+			continue
+		}
+		seen[value] = true
+		options = append(options, value)
+	}
+	if len(options) < 2 {
+		return nil
+	}
+
+	rng.Shuffle(len(options), func(i, j int) { options[i], options[j] = options[j], options[i] })
+	return options
+}
+
+// readingItems 한자가 들어간 단어 중 읽는 법이 있는 단어로 읽기 문항 구성
+func readingItems(words []Word) []QuizReadingItem {
+	var items []QuizReadingItem
+	seen := make(map[string]bool)
+	for _, w := range words {
+		word, reading := strings.TrimSpace(w.Japanese), strings.TrimSpace(w.Reading)
+		if reading == "" || seen[word] || !hasKanji(word) {
+			continue
+		}
+		seen[word] = true
+		items = append(items, QuizReadingItem{Word: word, Reading: reading})
+		if len(items) == quizReadingMaxItems {
+			break
+		}
+	}
+	return items
+}
+
+func hasKanji(s string) bool {
+	for _, r := range s {
+		if unicode.Is(unicode.Han, r) {
+			return true
+		}
+	}
+	return false
+}
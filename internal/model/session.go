@@ -0,0 +1,22 @@
+package model
+
+import "time"
+
+// UserSession 로그인한 기기 세션
+// 로그인할 때마다 refresh token family 하나당 하나씩 생성되고, 토큰 갱신 시 LastSeenAt이 갱신됩니다.
+type UserSession struct {
+	ID         uint       `gorm:"primaryKey" json:"id"`
+	UserID     uint       `gorm:"index;not null" json:"user_id"`
+	FamilyID   string     `gorm:"uniqueIndex;size:64;not null" json:"-"` // refresh token family
+	UserAgent  string     `gorm:"size:512" json:"user_agent"`
+	Platform   string     `gorm:"size:20" json:"platform"` // ios, android, web, unknown
+	IP         string     `gorm:"size:64" json:"ip"`
+	LastSeenAt time.Time  `json:"last_seen_at"`
+	RevokedAt  *time.Time `gorm:"index" json:"-"`
+	CreatedAt  time.Time  `json:"created_at"`
+	UpdatedAt  time.Time  `json:"updated_at"`
+}
+
+func (UserSession) TableName() string {
+	return "user_sessions"
+}
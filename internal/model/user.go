@@ -7,18 +7,38 @@ import (
 )
 
 type User struct {
-	ID         uint           `gorm:"primaryKey" json:"id"`
-	Email      string         `gorm:"uniqueIndex;size:255;not null" json:"email"`
-	Name       string         `gorm:"size:100" json:"name"`
-	Provider   string         `gorm:"size:50;not null" json:"provider"`     // google
-	ProviderID string         `gorm:"size:255;not null" json:"provider_id"` // OAuth provider's user ID
-	CreatedAt  time.Time      `json:"created_at"`
-	UpdatedAt  time.Time      `json:"updated_at"`
-	DeletedAt  gorm.DeletedAt `gorm:"index" json:"-"`
+	ID                  uint           `gorm:"primaryKey" json:"id"`
+	Email               string         `gorm:"uniqueIndex;size:255;not null" json:"email"`
+	Name                string         `gorm:"size:100" json:"name"`
+	Provider            string         `gorm:"size:50;not null" json:"provider"`            // 가입 시 사용한 공급자 (연결된 로그인 수단은 Identities)
+	ProviderID          string         `gorm:"size:255;not null" json:"provider_id"`        // OAuth provider's user ID
+	PasswordHash        string         `gorm:"size:255" json:"-"`                           // 이메일/비밀번호 계정만 (bcrypt)
+	Role                string         `gorm:"size:20;not null;default:'user'" json:"role"` // user, operator, admin (pkg.Role)
+	EmailVerifiedAt     *time.Time     `json:"email_verified_at,omitempty"`
+	DeletionScheduledAt *time.Time     `gorm:"index" json:"deletion_scheduled_at,omitempty"` // 탈퇴 요청 시 영구 삭제 예정 시각 (다시 로그인하면 취소)
+	QuizSeed            int64          `gorm:"not null;default:0" json:"-"`                  // 퀴즈 보기 순서 시드 (0이면 미발급, GetQuizSeed에서 발급)
+	CreatedAt           time.Time      `json:"created_at"`
+	UpdatedAt           time.Time      `json:"updated_at"`
+	DeletedAt           gorm.DeletedAt `gorm:"index" json:"-"`
 
 	// Relations
 	Settings   *UserSettings   `gorm:"foreignKey:UserID" json:"settings,omitempty"`
 	Onboarding *UserOnboarding `gorm:"foreignKey:UserID" json:"onboarding,omitempty"`
+	Identities []UserIdentity  `gorm:"foreignKey:UserID" json:"identities,omitempty"`
+}
+
+// UserIdentity 사용자에 연결된 로그인 수단
+// 한 사용자는 공급자별로 하나씩 여러 identity를 가질 수 있고, (provider, provider_id)는 전체에서 유일합니다.
+type UserIdentity struct {
+	ID            uint       `gorm:"primaryKey" json:"id"`
+	UserID        uint       `gorm:"not null;uniqueIndex:idx_user_identities_user_provider" json:"user_id"`
+	Provider      string     `gorm:"size:50;not null;uniqueIndex:idx_user_identities_user_provider;uniqueIndex:idx_user_identities_subject" json:"provider"` // google, kakao, line, apple (pkg.OAuthProvider*)
+	ProviderID    string     `gorm:"size:255;not null;uniqueIndex:idx_user_identities_subject" json:"-"`
+	Email         string     `gorm:"size:255" json:"email"`
+	EmailVerified bool       `gorm:"default:false" json:"email_verified"` // 공급자가 확인한 이메일인지
+	LastLoginAt   *time.Time `json:"last_login_at,omitempty"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
 }
 
 type UserSettings struct {
@@ -29,6 +49,7 @@ type UserSettings struct {
 	PreferredVoiceSpeed float64   `gorm:"default:1.0" json:"preferred_voice_speed"`
 	ShowRomaji          bool      `gorm:"default:true" json:"show_romaji"`
 	ShowTranslation     bool      `gorm:"default:true" json:"show_translation"`
+	Timezone            string    `gorm:"size:64;not null;default:'Asia/Seoul'" json:"timezone"` // IANA 타임존, "오늘"의 기준
 	CreatedAt           time.Time `json:"created_at"`
 	UpdatedAt           time.Time `json:"updated_at"`
 }
@@ -36,7 +57,7 @@ type UserSettings struct {
 type UserOnboarding struct {
 	ID        uint      `gorm:"primaryKey" json:"id"`
 	UserID    uint      `gorm:"uniqueIndex;not null" json:"user_id"`
-	Level     int       `gorm:"default:0" json:"level"`                 // 0 ~ 5 (pkg.Level)
+	Level     int       `gorm:"default:0" json:"level"`                      // 0 ~ 5 (pkg.Level)
 	Interests []int     `gorm:"type:jsonb;serializer:json" json:"interests"` // pkg.SubCategory 값들
 	Purposes  []int     `gorm:"type:jsonb;serializer:json" json:"purposes"`  // pkg.Purpose 값들
 	CreatedAt time.Time `json:"created_at"`
@@ -47,6 +68,10 @@ func (User) TableName() string {
 	return "users"
 }
 
+func (UserIdentity) TableName() string {
+	return "user_identities"
+}
+
 func (UserSettings) TableName() string {
 	return "user_settings"
 }
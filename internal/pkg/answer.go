@@ -0,0 +1,120 @@
+package pkg
+
+import (
+	"strings"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// AnswerMatch 입력한 답 채점 결과
+type AnswerMatch string
+
+const (
+	AnswerExact      AnswerMatch = "exact"      // 정답과 똑같음
+	AnswerAcceptable AnswerMatch = "acceptable" // 표기만 다름 (반각/전각, 가타카나/히라가나, 장음, 문장부호, 로마지)
+	AnswerWrong      AnswerMatch = "wrong"      // 오답
+)
+
+// IsCorrect 정답으로 인정하는지 (exact 또는 acceptable)
+func (m AnswerMatch) IsCorrect() bool {
+	return m == AnswerExact || m == AnswerAcceptable
+}
+
+// DiffOp 답안 비교 구간 종류
+type DiffOp string
+
+const (
+	DiffEqual   DiffOp = "equal"   // 정답과 같은 부분
+	DiffMissing DiffOp = "missing" // 정답에는 있는데 답에 빠진 부분
+	DiffExtra   DiffOp = "extra"   // 답에만 있는 부분
+)
+
+// DiffSegment 답안 비교 구간
+type DiffSegment struct {
+	Op   DiffOp `json:"op"`
+	Text string `json:"text"`
+}
+
+// AnswerResult 입력한 답 채점 결과와 정답과의 차이
+type AnswerResult struct {
+	Match AnswerMatch
+	Diff  []DiffSegment // exact이면 nil
+}
+
+// GradeJapaneseAnswer 입력한 일본어 답을 정답과 비교
+// 앞뒤 공백만 다르면 exact, NormalizeJapanese 결과가 같으면 acceptable, 그 외는 wrong입니다.
+// exact가 아니면 글자 단위 차이(NFKC 기준)를 함께 돌려줍니다.
+func GradeJapaneseAnswer(answer, correct string, opts JapaneseNormalizeOptions) AnswerResult {
+	answer, correct = strings.TrimSpace(answer), strings.TrimSpace(correct)
+	if answer == correct {
+		return AnswerResult{Match: AnswerExact}
+	}
+
+	result := AnswerResult{
+		Match: AnswerWrong,
+		Diff:  DiffText(norm.NFKC.String(answer), norm.NFKC.String(correct)),
+	}
+	if normalized := NormalizeJapanese(correct, opts); normalized != "" && NormalizeJapanese(answer, opts) == normalized {
+		result.Match = AnswerAcceptable
+	}
+	return result
+}
+
+// maxDiffCells 글자 단위 차이를 계산할 최대 크기 (답 길이 × 정답 길이, 넘으면 통째로 다르다고 표시)
+const maxDiffCells = 256 * 256
+
+// DiffText 답과 정답의 글자 단위 차이 (최장 공통 부분열 기준, 같은 종류의 연속 구간은 합침)
+// 답이 너무 길면 계산하지 않고 답 전체를 extra, 정답 전체를 missing으로 돌려줍니다.
+func DiffText(answer, correct string) []DiffSegment {
+	a, c := []rune(answer), []rune(correct)
+	if len(a)*len(c) > maxDiffCells {
+		var segments []DiffSegment
+		if len(a) > 0 {
+			segments = append(segments, DiffSegment{Op: DiffExtra, Text: answer})
+		}
+		if len(c) > 0 {
+			segments = append(segments, DiffSegment{Op: DiffMissing, Text: correct})
+		}
+		return segments
+	}
+
+	// lcs[i*width+j] = a[i:]와 c[j:]의 최장 공통 부분열 길이
+	width := len(c) + 1
+	lcs := make([]int, (len(a)+1)*width)
+	at := func(i, j int) int { return lcs[i*width+j] }
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(c) - 1; j >= 0; j-- {
+			if a[i] == c[j] {
+				lcs[i*width+j] = at(i+1, j+1) + 1
+			} else {
+				lcs[i*width+j] = max(at(i+1, j), at(i, j+1))
+			}
+		}
+	}
+
+	var segments []DiffSegment
+	add := func(op DiffOp, r rune) {
+		if n := len(segments); n > 0 && segments[n-1].Op == op {
+			segments[n-1].Text += string(r)
+			return
+		}
+		segments = append(segments, DiffSegment{Op: op, Text: string(r)})
+	}
+
+	i, j := 0, 0
+	for i < len(a) || j < len(c) {
+		switch {
+		case i < len(a) && j < len(c) && a[i] == c[j]:
+			add(DiffEqual, a[i])
+			i++
+			j++
+		case j < len(c) && (i == len(a) || at(i, j+1) >= at(i+1, j)):
+			add(DiffMissing, c[j])
+			j++
+		default:
+			add(DiffExtra, a[i])
+			i++
+		}
+	}
+	return segments
+}
@@ -0,0 +1,108 @@
+package pkg
+
+import (
+	"log"
+	"sync"
+	"time"
+	_ "time/tzdata" // 컨테이너에 tzdata가 없어도 IANA 타임존을 읽을 수 있도록 내장
+)
+
+// DateLayout 날짜(하루) 문자열 형식
+const DateLayout = "2006-01-02"
+
+// Clock 현재 시각 제공자 (테스트에서는 고정 시각으로 대체)
+type Clock interface {
+	Now() time.Time
+}
+
+// ClockFunc 함수를 Clock으로 사용
+type ClockFunc func() time.Time
+
+// Now 현재 시각
+func (f ClockFunc) Now() time.Time {
+	return f()
+}
+
+// SystemClock 실제 시스템 시각
+var SystemClock Clock = ClockFunc(time.Now)
+
+// Calendar 사용자 타임존 기준 날짜 계산
+// "오늘", 학습 기록의 날짜, streak, 주간 통계 등 하루의 경계가 필요한 계산은 모두 여기를 거칩니다.
+// 날짜(하루)는 해당 날짜의 UTC 자정 time.Time으로 표현해 DB의 date 컬럼과 그대로 비교할 수 있습니다.
+type Calendar struct {
+	clock           Clock
+	defaultLocation *time.Location
+	locations       sync.Map // tz 이름 -> *time.Location
+}
+
+// NewCalendar Calendar 생성자
+// defaultTimezone이 올바르지 않으면 UTC를 기본값으로 사용합니다.
+func NewCalendar(clock Clock, defaultTimezone string) *Calendar {
+	loc, err := time.LoadLocation(defaultTimezone)
+	if err != nil {
+		log.Printf("Warning: invalid default timezone %q, using UTC: %v", defaultTimezone, err)
+		loc = time.UTC
+	}
+	return &Calendar{clock: clock, defaultLocation: loc}
+}
+
+// Now 현재 시각
+func (c *Calendar) Now() time.Time {
+	return c.clock.Now()
+}
+
+// Location IANA 타임존 이름을 time.Location으로 변환 (비어 있거나 잘못된 값이면 기본 타임존)
+func (c *Calendar) Location(timezone string) *time.Location {
+	if timezone == "" {
+		return c.defaultLocation
+	}
+	if loc, ok := c.locations.Load(timezone); ok {
+		return loc.(*time.Location)
+	}
+
+	loc, err := time.LoadLocation(timezone)
+	if err != nil || timezone == "Local" {
+		return c.defaultLocation
+	}
+	c.locations.Store(timezone, loc)
+	return loc
+}
+
+// Today 해당 타임존의 오늘 날짜
+func (c *Calendar) Today(timezone string) time.Time {
+	return c.DateOf(c.clock.Now(), timezone)
+}
+
+// DateOf 시각 t가 해당 타임존에서 속한 날짜
+func (c *Calendar) DateOf(t time.Time, timezone string) time.Time {
+	y, m, d := t.In(c.Location(timezone)).Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+// DayRange 날짜 하루가 해당 타임존에서 시작하고 끝나는 시각 [start, end)
+func (c *Calendar) DayRange(date time.Time, timezone string) (time.Time, time.Time) {
+	loc := c.Location(timezone)
+	y, m, d := date.Date()
+	start := time.Date(y, m, d, 0, 0, 0, 0, loc)
+	end := time.Date(y, m, d+1, 0, 0, 0, 0, loc)
+	return start, end
+}
+
+// LastDays 오늘을 포함한 최근 n일의 날짜 (오래된 날짜부터)
+func (c *Calendar) LastDays(n int, timezone string) []time.Time {
+	today := c.Today(timezone)
+	days := make([]time.Time, 0, n)
+	for i := n - 1; i >= 0; i-- {
+		days = append(days, today.AddDate(0, 0, -i))
+	}
+	return days
+}
+
+// IsValidTimezone 사용자가 보낸 IANA 타임존 이름 검증 (예: "Asia/Seoul")
+func IsValidTimezone(timezone string) bool {
+	if timezone == "" || timezone == "Local" {
+		return false
+	}
+	_, err := time.LoadLocation(timezone)
+	return err == nil
+}
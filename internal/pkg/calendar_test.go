@@ -0,0 +1,116 @@
+package pkg
+
+import (
+	"testing"
+	"time"
+)
+
+// fixedClock 고정 시각 Clock
+func fixedClock(t time.Time) Clock {
+	return ClockFunc(func() time.Time { return t })
+}
+
+func date(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func TestCalendarTodayAcrossKSTMidnight(t *testing.T) {
+	tests := []struct {
+		name     string
+		now      time.Time
+		timezone string
+		want     time.Time
+	}{
+		// KST(UTC+9) 자정은 UTC 15:00
+		{"KST just before midnight", time.Date(2026, 3, 1, 14, 59, 59, 0, time.UTC), "Asia/Seoul", date(2026, 3, 1)},
+		{"KST midnight", time.Date(2026, 3, 1, 15, 0, 0, 0, time.UTC), "Asia/Seoul", date(2026, 3, 2)},
+		{"KST after midnight", time.Date(2026, 3, 1, 15, 0, 1, 0, time.UTC), "Asia/Seoul", date(2026, 3, 2)},
+		{"UTC same instant", time.Date(2026, 3, 1, 15, 0, 0, 0, time.UTC), "UTC", date(2026, 3, 1)},
+		{"KST year boundary", time.Date(2025, 12, 31, 15, 0, 0, 0, time.UTC), "Asia/Seoul", date(2026, 1, 1)},
+		{"empty timezone uses default", time.Date(2026, 3, 1, 15, 0, 0, 0, time.UTC), "", date(2026, 3, 2)},
+		{"invalid timezone uses default", time.Date(2026, 3, 1, 15, 0, 0, 0, time.UTC), "Mars/Base", date(2026, 3, 2)},
+		{"Local uses default", time.Date(2026, 3, 1, 15, 0, 0, 0, time.UTC), "Local", date(2026, 3, 2)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cal := NewCalendar(fixedClock(tt.now), "Asia/Seoul")
+			if got := cal.Today(tt.timezone); !got.Equal(tt.want) {
+				t.Errorf("Today(%q) = %s, want %s", tt.timezone, got.Format(DateLayout), tt.want.Format(DateLayout))
+			}
+		})
+	}
+}
+
+func TestCalendarDayRange(t *testing.T) {
+	cal := NewCalendar(SystemClock, "Asia/Seoul")
+
+	tests := []struct {
+		name      string
+		date      time.Time
+		timezone  string
+		wantStart time.Time
+		wantEnd   time.Time
+	}{
+		{"KST day", date(2026, 3, 2), "Asia/Seoul",
+			time.Date(2026, 3, 1, 15, 0, 0, 0, time.UTC), time.Date(2026, 3, 2, 15, 0, 0, 0, time.UTC)},
+		{"KST month end", date(2026, 2, 28), "Asia/Seoul",
+			time.Date(2026, 2, 27, 15, 0, 0, 0, time.UTC), time.Date(2026, 2, 28, 15, 0, 0, 0, time.UTC)},
+		{"UTC day", date(2026, 3, 2), "UTC",
+			time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC), time.Date(2026, 3, 3, 0, 0, 0, 0, time.UTC)},
+		// 서머타임이 시작되는 날은 23시간
+		{"DST start", date(2026, 3, 8), "America/New_York",
+			time.Date(2026, 3, 8, 5, 0, 0, 0, time.UTC), time.Date(2026, 3, 9, 4, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, end := cal.DayRange(tt.date, tt.timezone)
+			if !start.Equal(tt.wantStart) || !end.Equal(tt.wantEnd) {
+				t.Errorf("DayRange(%s, %q) = [%s, %s), want [%s, %s)", tt.date.Format(DateLayout), tt.timezone,
+					start.UTC(), end.UTC(), tt.wantStart, tt.wantEnd)
+			}
+		})
+	}
+
+	// Today의 범위는 현재 시각을 포함합니다.
+	for _, now := range []time.Time{
+		time.Date(2026, 3, 1, 14, 59, 59, 0, time.UTC),
+		time.Date(2026, 3, 1, 15, 0, 0, 0, time.UTC),
+	} {
+		cal := NewCalendar(fixedClock(now), "Asia/Seoul")
+		start, end := cal.DayRange(cal.Today("Asia/Seoul"), "Asia/Seoul")
+		if now.Before(start) || !now.Before(end) {
+			t.Errorf("now %s is outside today's range [%s, %s)", now, start.UTC(), end.UTC())
+		}
+	}
+}
+
+func TestCalendarLastDays(t *testing.T) {
+	tests := []struct {
+		name string
+		now  time.Time
+		n    int
+		want []time.Time
+	}{
+		{"before KST midnight", time.Date(2026, 3, 1, 14, 59, 0, 0, time.UTC), 3,
+			[]time.Time{date(2026, 2, 27), date(2026, 2, 28), date(2026, 3, 1)}},
+		{"after KST midnight", time.Date(2026, 3, 1, 15, 0, 0, 0, time.UTC), 3,
+			[]time.Time{date(2026, 2, 28), date(2026, 3, 1), date(2026, 3, 2)}},
+		{"today only", time.Date(2026, 3, 1, 15, 0, 0, 0, time.UTC), 1,
+			[]time.Time{date(2026, 3, 2)}},
+		{"none", time.Date(2026, 3, 1, 15, 0, 0, 0, time.UTC), 0, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cal := NewCalendar(fixedClock(tt.now), "UTC")
+			got := cal.LastDays(tt.n, "Asia/Seoul")
+			if len(got) != len(tt.want) {
+				t.Fatalf("LastDays(%d) returned %d days, want %d", tt.n, len(got), len(tt.want))
+			}
+			for i := range got {
+				if !got[i].Equal(tt.want[i]) {
+					t.Errorf("LastDays(%d)[%d] = %s, want %s", tt.n, i, got[i].Format(DateLayout), tt.want[i].Format(DateLayout))
+				}
+			}
+		})
+	}
+}
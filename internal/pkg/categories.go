@@ -2,25 +2,27 @@ package pkg
 
 import "fmt"
 
-// type Category int
+// Category SubCategory의 상위 분야 (SubCategory 번호대로 결정, CategoryParent 참고)
+type Category int
 
-// const (
-// 	CategoryAnime     Category = 1 // 애니/만화
-// 	CategoryGame      Category = 2 // 게임
-// 	CategoryMusic     Category = 3 // 음악
-// 	CategoryVtuber    Category = 4 // 버튜버(독립)
-// 	CategoryLifestyle Category = 5 // 오타쿠 라이프스타일
-// 	CategorySituation Category = 6 // 실전 오타쿠 상황
-// )
+const (
+	CategoryAnime     Category = 1 // 애니/만화
+	CategoryGame      Category = 2 // 게임
+	CategoryMusic     Category = 3 // 음악
+	CategoryVtuber    Category = 4 // 버튜버(독립)
+	CategoryLifestyle Category = 5 // 오타쿠 라이프스타일
+	CategorySituation Category = 6 // 실전 오타쿠 상황
+)
 
-type Category int
+// ConversationType 문장의 대화 유형
+type ConversationType int
 
 const (
-    CategoryImpression Category = 1 // 감상·평가 (재밌다/별로다/인상적이다)
-    CategoryReaction   Category = 2 // 공감·맞장구 (나도 그래/그건 좀 의외)
-    CategoryQuestion   Category = 3 // 의문·확장 (왜?/어떻게 생각해?)
-    CategoryComparison Category = 4 // 비교·선호 (A보다 B/전작 vs 이번작)
-    CategorySituation  Category = 5 // 상황·행동 (현장/주문/만남/실전)
+	ConversationImpression ConversationType = 1 // 감상·평가 (재밌다/별로다/인상적이다)
+	ConversationReaction   ConversationType = 2 // 공감·맞장구 (나도 그래/그건 좀 의외)
+	ConversationQuestion   ConversationType = 3 // 의문·확장 (왜?/어떻게 생각해?)
+	ConversationComparison ConversationType = 4 // 비교·선호 (A보다 B/전작 vs 이번작)
+	ConversationSituation  ConversationType = 5 // 상황·행동 (현장/주문/만남/실전)
 )
 
 // SubCategory: 통합된 상세 카테고리 (총 17개)
@@ -202,20 +204,20 @@ func (k SentenceKey) String() string {
 // SubCategoryName SubCategory의 한글 이름 반환
 func (s SubCategory) Name() string {
 	names := map[SubCategory]string{
-		SubCategoryAnimeBattleFantasySF: "배틀/판타지·SF",
-		SubCategoryAnimeSliceLoveEmo:    "일상/러브코미·감성",
-		SubCategoryAnimeStoryMystery:    "서사/추리",
-		SubCategoryGameRpgGacha:         "RPG/가챠",
-		SubCategoryGameRhythm:           "리듬게임",
-		SubCategoryGameActionVsShoo:     "액션/대전·슈터",
-		SubCategoryMusicJpop:            "J-POP",
-		SubCategoryMusicIdol:            "아이돌",
-		SubCategoryMusicAnime:           "애니송",
-		SubCategoryVtuber:               "버튜버",
-		SubCategoryLifePilgrimageTravel: "성지순례/여행",
-		SubCategoryLifeGoodsCollect:     "굿즈/수집",
-		SubCategoryLifeComiketDoujin:    "코미케/동인",
-		SubCategorySitShoppingOrder:     "쇼핑/주문",
+		SubCategoryAnimeBattleFantasySF:  "배틀/판타지·SF",
+		SubCategoryAnimeSliceLoveEmo:     "일상/러브코미·감성",
+		SubCategoryAnimeStoryMystery:     "서사/추리",
+		SubCategoryGameRpgGacha:          "RPG/가챠",
+		SubCategoryGameRhythm:            "리듬게임",
+		SubCategoryGameActionVsShoo:      "액션/대전·슈터",
+		SubCategoryMusicJpop:             "J-POP",
+		SubCategoryMusicIdol:             "아이돌",
+		SubCategoryMusicAnime:            "애니송",
+		SubCategoryVtuber:                "버튜버",
+		SubCategoryLifePilgrimageTravel:  "성지순례/여행",
+		SubCategoryLifeGoodsCollect:      "굿즈/수집",
+		SubCategoryLifeComiketDoujin:     "코미케/동인",
+		SubCategorySitShoppingOrder:      "쇼핑/주문",
 		SubCategorySitOnsiteLiveGreeting: "현장/라이브",
 		SubCategorySitOtakuTalk:          "오타쿠 대화",
 		SubCategorySitCollabCafeGameCtr:  "콜라보카페/게임센터",
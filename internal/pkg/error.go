@@ -6,15 +6,32 @@ import (
 )
 
 var (
-	ErrNotFound           = errors.New("resource not found")
-	ErrUnauthorized       = errors.New("unauthorized")
-	ErrForbidden          = errors.New("forbidden")
-	ErrBadRequest         = errors.New("bad request")
-	ErrInternalServer     = errors.New("internal server error")
-	ErrDuplicateEmail     = errors.New("email already exists")
-	ErrInvalidCredentials = errors.New("invalid credentials")
-	ErrTokenExpired       = errors.New("token expired")
-	ErrInvalidToken       = errors.New("invalid token")
+	ErrNotFound              = errors.New("resource not found")
+	ErrUnauthorized          = errors.New("unauthorized")
+	ErrForbidden             = errors.New("forbidden")
+	ErrBadRequest            = errors.New("bad request")
+	ErrInternalServer        = errors.New("internal server error")
+	ErrDuplicateEmail        = errors.New("email already exists")
+	ErrInvalidCredentials    = errors.New("invalid credentials")
+	ErrTokenExpired          = errors.New("token expired")
+	ErrInvalidToken          = errors.New("invalid token")
+	ErrTokenRevoked          = errors.New("token revoked")
+	ErrTokenReused           = errors.New("token reuse detected")
+	ErrInvalidOAuthState     = errors.New("invalid oauth state")
+	ErrOAuthStateUsed        = errors.New("oauth state already used or expired")
+	ErrRedirectNotAllowed    = errors.New("redirect uri not allowed")
+	ErrInvalidOAuthClient    = errors.New("invalid oauth client type")
+	ErrPKCERequired          = errors.New("code_challenge (S256) is required")
+	ErrInvalidLoginCode      = errors.New("invalid or expired login code")
+	ErrUnknownOAuthProvider  = errors.New("unknown oauth provider")
+	ErrIdentityAlreadyLinked = errors.New("another account of this provider is already linked")
+	ErrLastIdentity          = errors.New("cannot unlink the last login method")
+	ErrMergeConflict         = errors.New("both accounts have identities of the same provider")
+	ErrInvalidMergeTicket    = errors.New("invalid or expired merge ticket")
+	ErrInvalidEmailToken     = errors.New("invalid or expired email token")
+	ErrAlreadyBookmarked     = errors.New("sentence already bookmarked")
+	ErrDuplicateFolder       = errors.New("bookmark folder with the same name exists")
+	ErrUnknownTaxonomyCode   = errors.New("unknown level, interest or purpose code")
 )
 
 type AppError struct {
@@ -0,0 +1,5 @@
+package pkg
+
+// GuestAuthProvider 게스트 계정의 provider 값
+// 게스트는 연결된 로그인 수단(identity) 없이 발급받은 토큰으로만 로그인 상태를 유지합니다.
+const GuestAuthProvider = "guest"
@@ -0,0 +1,195 @@
+package pkg
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// JapaneseNormalizeOptions 일본어 답안 정규화 옵션
+type JapaneseNormalizeOptions struct {
+	Romaji bool // 로마지 입력을 히라가나로 변환 (정답에 알파벳이 없는 가나 답안에만 사용)
+}
+
+// NormalizeJapanese 답안 비교용 일본어 정규화
+// NFKC로 반각/전각을 통일하고 소문자로 바꾼 뒤, 가타카나를 히라가나로 접고
+// 장음 부호(ー)를 앞 글자의 모음으로 바꿉니다(ラーメン → らあめん). 공백, 문장부호, 기호는 지웁니다.
+func NormalizeJapanese(s string, opts JapaneseNormalizeOptions) string {
+	s = strings.ToLower(norm.NFKC.String(s))
+	if opts.Romaji {
+		s = RomajiToKana(s)
+	}
+
+	var b strings.Builder
+	b.Grow(len(s))
+	var prev rune
+	for _, r := range s {
+		// 가나 뒤의 하이픈은 장음으로 씀 (ラーメン을 ら-めん으로 입력한 경우)
+		if r == '-' && isKana(prev) {
+			r = 'ー'
+		}
+		if unicode.IsSpace(r) || unicode.IsPunct(r) || unicode.IsSymbol(r) {
+			continue
+		}
+		r = toHiragana(r)
+		if r == 'ー' {
+			if vowel, ok := kanaVowels[prev]; ok {
+				r = vowel
+			}
+		}
+		b.WriteRune(r)
+		prev = r
+	}
+	return b.String()
+}
+
+// toHiragana 가타카나를 히라가나로 (ァ(U+30A1) ~ ヶ(U+30F6) → ぁ(U+3041) ~ ゖ(U+3096))
+func toHiragana(r rune) rune {
+	if r >= 'ァ' && r <= 'ヶ' {
+		return r - ('ァ' - 'ぁ')
+	}
+	return r
+}
+
+func isKana(r rune) bool {
+	return (r >= 'ぁ' && r <= 'ゖ') || (r >= 'ァ' && r <= 'ヺ') || r == 'ー'
+}
+
+// kanaVowels 히라가나별 모음 (장음 부호를 모음 글자로 바꿀 때 사용)
+var kanaVowels = func() map[rune]rune {
+	groups := map[rune]string{
+		'あ': "あかさたなはまやらわがざだばぱぁゃゎ",
+		'い': "いきしちにひみりぎじぢびぴぃ",
+		'う': "うくすつぬふむゆるぐずづぶぷぅゅゔ",
+		'え': "えけせてねへめれげぜでべぺぇ",
+		'お': "おこそとのほもよろをごぞどぼぽぉょ",
+	}
+	vowels := make(map[rune]rune)
+	for vowel, kana := range groups {
+		for _, r := range kana {
+			vowels[r] = vowel
+		}
+	}
+	return vowels
+}()
+
+// romajiKana 로마지 음절 → 히라가나 (헵번식과 훈령식 표기 모두)
+var romajiKana = map[string]string{
+	"a": "あ", "i": "い", "u": "う", "e": "え", "o": "お",
+	"ka": "か", "ki": "き", "ku": "く", "ke": "け", "ko": "こ",
+	"sa": "さ", "si": "し", "shi": "し", "su": "す", "se": "せ", "so": "そ",
+	"ta": "た", "ti": "ち", "chi": "ち", "tu": "つ", "tsu": "つ", "te": "て", "to": "と",
+	"na": "な", "ni": "に", "nu": "ぬ", "ne": "ね", "no": "の",
+	"ha": "は", "hi": "ひ", "hu": "ふ", "fu": "ふ", "he": "へ", "ho": "ほ",
+	"ma": "ま", "mi": "み", "mu": "む", "me": "め", "mo": "も",
+	"ya": "や", "yu": "ゆ", "yo": "よ",
+	"ra": "ら", "ri": "り", "ru": "る", "re": "れ", "ro": "ろ",
+	"wa": "わ", "wo": "を",
+	"ga": "が", "gi": "ぎ", "gu": "ぐ", "ge": "げ", "go": "ご",
+	"za": "ざ", "zi": "じ", "ji": "じ", "zu": "ず", "ze": "ぜ", "zo": "ぞ",
+	"da": "だ", "di": "ぢ", "du": "づ", "de": "で", "do": "ど",
+	"ba": "ば", "bi": "び", "bu": "ぶ", "be": "べ", "bo": "ぼ",
+	"pa": "ぱ", "pi": "ぴ", "pu": "ぷ", "pe": "ぺ", "po": "ぽ",
+	"kya": "きゃ", "kyu": "きゅ", "kyo": "きょ",
+	"sya": "しゃ", "syu": "しゅ", "syo": "しょ", "sha": "しゃ", "shu": "しゅ", "she": "しぇ", "sho": "しょ",
+	"tya": "ちゃ", "tyu": "ちゅ", "tyo": "ちょ", "cha": "ちゃ", "chu": "ちゅ", "che": "ちぇ", "cho": "ちょ",
+	"nya": "にゃ", "nyu": "にゅ", "nyo": "にょ",
+	"hya": "ひゃ", "hyu": "ひゅ", "hyo": "ひょ",
+	"mya": "みゃ", "myu": "みゅ", "myo": "みょ",
+	"rya": "りゃ", "ryu": "りゅ", "ryo": "りょ",
+	"gya": "ぎゃ", "gyu": "ぎゅ", "gyo": "ぎょ",
+	"zya": "じゃ", "zyu": "じゅ", "zyo": "じょ", "ja": "じゃ", "ju": "じゅ", "je": "じぇ", "jo": "じょ",
+	"jya": "じゃ", "jyu": "じゅ", "jyo": "じょ",
+	"bya": "びゃ", "byu": "びゅ", "byo": "びょ",
+	"pya": "ぴゃ", "pyu": "ぴゅ", "pyo": "ぴょ",
+	"fa": "ふぁ", "fi": "ふぃ", "fe": "ふぇ", "fo": "ふぉ",
+	"va": "ゔぁ", "vi": "ゔぃ", "vu": "ゔ", "ve": "ゔぇ", "vo": "ゔぉ",
+	"-": "ー",
+}
+
+// romajiMacrons 헵번식 장음 표기 (ō → ou 등)
+var romajiMacrons = strings.NewReplacer(
+	"ā", "aa", "ī", "ii", "ū", "uu", "ē", "ee", "ō", "ou",
+	"â", "aa", "î", "ii", "û", "uu", "ê", "ee", "ô", "ou",
+)
+
+// RomajiToKana 로마지를 히라가나로 변환 (소문자 입력 기준, 변환할 수 없는 글자는 그대로 둠)
+// 같은 자음이 겹치면 촉음(kk → っk), 모음/y가 뒤따르지 않는 n과 b/p/m 앞의 m은 ん으로 바꿉니다(nn, n'도 ん).
+func RomajiToKana(s string) string {
+	s = romajiMacrons.Replace(s)
+
+	var b strings.Builder
+	b.Grow(len(s) * 2)
+	for i := 0; i < len(s); {
+		c := s[i]
+		if c < 'a' || c > 'z' {
+			if c == '-' {
+				b.WriteString("ー")
+				i++
+				continue
+			}
+			// ASCII가 아닌 글자는 UTF-8 바이트 그대로 복사
+			j := i + 1
+			for j < len(s) && s[j] >= 0x80 && s[j] < 0xC0 {
+				j++
+			}
+			b.WriteString(s[i:j])
+			i = j
+			continue
+		}
+
+		var next byte
+		if i+1 < len(s) {
+			next = s[i+1]
+		}
+
+		// 헵번식 m + b/p/m (sempai → せんぱい)
+		if c == 'm' && (next == 'b' || next == 'p' || next == 'm') {
+			b.WriteString("ん")
+			i++
+			continue
+		}
+
+		// 촉음: 같은 자음 반복 또는 tch
+		if (c == next && c != 'n' && !isRomajiVowel(c)) || (c == 't' && strings.HasPrefix(s[i+1:], "ch")) {
+			b.WriteString("っ")
+			i++
+			continue
+		}
+
+		// ん: 뒤에 모음이나 y가 오지 않는 n
+		if c == 'n' && !isRomajiVowel(next) && next != 'y' {
+			b.WriteString("ん")
+			i++
+			if next == '\'' {
+				i++
+			} else if next == 'n' && (i+1 >= len(s) || (!isRomajiVowel(s[i+1]) && s[i+1] != 'y')) {
+				i++ // nn 뒤에 모음이 없으면 두 글자를 ん 하나로
+			}
+			continue
+		}
+
+		matched := false
+		for l := 3; l >= 1; l-- {
+			if i+l > len(s) {
+				continue
+			}
+			if kana, ok := romajiKana[s[i:i+l]]; ok {
+				b.WriteString(kana)
+				i += l
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			b.WriteByte(c)
+			i++
+		}
+	}
+	return b.String()
+}
+
+func isRomajiVowel(c byte) bool {
+	return c == 'a' || c == 'i' || c == 'u' || c == 'e' || c == 'o'
+}
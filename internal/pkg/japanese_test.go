@@ -0,0 +1,124 @@
+package pkg
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestRomajiToKana(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"konnichiha", "こんにちは"},
+		{"konnnichiha", "こんにちは"},
+		{"kitte", "きって"},
+		{"matcha", "まっちゃ"},
+		{"zasshi", "ざっし"},
+		{"sempai", "せんぱい"},
+		{"shimbun", "しんぶん"},
+		{"minna", "みんな"},
+		{"hon", "ほん"},
+		{"honn", "ほん"},
+		{"kon'ya", "こんや"},
+		{"konya", "こにゃ"},
+		{"tsukue", "つくえ"},
+		{"tukue", "つくえ"},
+		{"kyou", "きょう"},
+		{"jidousha", "じどうしゃ"},
+		{"tōkyō", "とうきょう"},
+		{"ra-men", "らーめん"},
+		{"fairu", "ふぁいる"},
+		{"たべru", "たべる"},
+	}
+	for _, tt := range tests {
+		if got := RomajiToKana(tt.in); got != tt.want {
+			t.Errorf("RomajiToKana(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestNormalizeJapanese(t *testing.T) {
+	tests := []struct {
+		in     string
+		romaji bool
+		want   string
+	}{
+		{"ラーメン", false, "らあめん"},
+		{"らあめん", false, "らあめん"},
+		{"ら-めん", false, "らあめん"},
+		{"ｶﾞﾁｬ", false, "がちゃ"},
+		{"ガチャ", false, "がちゃ"},
+		{"コーヒー", false, "こおひい"},
+		{"食べる。", false, "食べる"},
+		{"  食べる！ ", false, "食べる"},
+		{"「はい」、そうです", false, "はいそうです"},
+		{"ＡＢＣ", false, "abc"},
+		{"taberu", true, "たべる"},
+		{"ＴＡＢＥＲＵ", true, "たべる"},
+		{"taberu", false, "taberu"},
+	}
+	for _, tt := range tests {
+		if got := NormalizeJapanese(tt.in, JapaneseNormalizeOptions{Romaji: tt.romaji}); got != tt.want {
+			t.Errorf("NormalizeJapanese(%q, romaji=%v) = %q, want %q", tt.in, tt.romaji, got, tt.want)
+		}
+	}
+}
+
+func TestGradeJapaneseAnswer(t *testing.T) {
+	tests := []struct {
+		answer  string
+		correct string
+		romaji  bool
+		want    AnswerMatch
+	}{
+		{"食べる", "食べる", false, AnswerExact},
+		{" 食べる ", "食べる", false, AnswerExact},
+		{"食べる。", "食べる", false, AnswerAcceptable},
+		{"食べる！", "食べる", false, AnswerAcceptable},
+		{"ラーメン", "らあめん", false, AnswerAcceptable},
+		{"ｶﾞﾁｬ", "ガチャ", false, AnswerAcceptable},
+		{"taberu", "たべる", true, AnswerAcceptable},
+		{"taberu", "たべる", false, AnswerWrong},
+		{"たべた", "たべる", true, AnswerWrong},
+		{"。", "。", false, AnswerExact},
+		{"！", "。", false, AnswerWrong},
+	}
+	for _, tt := range tests {
+		got := GradeJapaneseAnswer(tt.answer, tt.correct, JapaneseNormalizeOptions{Romaji: tt.romaji})
+		if got.Match != tt.want {
+			t.Errorf("GradeJapaneseAnswer(%q, %q) = %s, want %s", tt.answer, tt.correct, got.Match, tt.want)
+		}
+		if got.Match == AnswerExact && got.Diff != nil {
+			t.Errorf("GradeJapaneseAnswer(%q, %q) exact match returned diff %v", tt.answer, tt.correct, got.Diff)
+		}
+	}
+}
+
+func TestDiffText(t *testing.T) {
+	tests := []struct {
+		answer  string
+		correct string
+		want    []DiffSegment
+	}{
+		{"たべた", "たべる", []DiffSegment{{DiffEqual, "たべ"}, {DiffMissing, "る"}, {DiffExtra, "た"}}},
+		{"今日はいい天気", "今日はいい天気ですね", []DiffSegment{{DiffEqual, "今日はいい天気"}, {DiffMissing, "ですね"}}},
+		{"あのたべる", "たべる", []DiffSegment{{DiffExtra, "あの"}, {DiffEqual, "たべる"}}},
+		{"", "たべる", []DiffSegment{{DiffMissing, "たべる"}}},
+	}
+	for _, tt := range tests {
+		if got := DiffText(tt.answer, tt.correct); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("DiffText(%q, %q) = %v, want %v", tt.answer, tt.correct, got, tt.want)
+		}
+	}
+}
+
+func TestDiffTextTooLong(t *testing.T) {
+	answer := strings.Repeat("あ", 100000)
+	got := DiffText(answer, "たべる")
+	want := []DiffSegment{{DiffExtra, answer}, {DiffMissing, "たべる"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("DiffText(long answer) returned %d segments, want extra+missing", len(got))
+	}
+}
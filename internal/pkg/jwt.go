@@ -1,73 +1,172 @@
 package pkg
 
 import (
+	"crypto/rand"
+	"encoding/hex"
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 )
 
+// RefreshTokenDuration refresh token 유효 기간
+const RefreshTokenDuration = time.Hour * 24 * 7 // 7 days
+
+// TokenType 토큰 종류 (access / refresh)
+type TokenType string
+
+const (
+	TokenTypeAccess  TokenType = "access"
+	TokenTypeRefresh TokenType = "refresh"
+)
+
+// 토큰 종류별 audience
+const (
+	AccessTokenAudience  = "jptaku-api"
+	RefreshTokenAudience = "jptaku-auth"
+)
+
 type JWTClaims struct {
-	UserID uint   `json:"user_id"`
-	Email  string `json:"email"`
+	UserID    uint      `json:"user_id"`
+	Email     string    `json:"email"`
+	TokenType TokenType `json:"token_type"`
+	Role      Role      `json:"role,omitempty"` // access token만
+	FamilyID  string    `json:"fid,omitempty"`  // refresh token family (로테이션 체인 식별자, 기기 세션)
 	jwt.RegisteredClaims
 }
 
+// JWTManager JWT 발급/검증
+// 서명은 항상 현재 키(keyID)로 하고, 검증은 kid 헤더로 키를 골라서 합니다. (kid가 없으면 현재 키)
+// 키 로테이션 시 이전 키를 AddVerificationKey로 등록해 두면 기존 토큰이 만료될 때까지 유효합니다.
 type JWTManager struct {
-	secretKey       []byte
+	keyID           string
+	keys            map[string][]byte
 	expirationHours int
 }
 
-func NewJWTManager(secret string, expirationHours int) *JWTManager {
+func NewJWTManager(keyID, secret string, expirationHours int) *JWTManager {
 	return &JWTManager{
-		secretKey:       []byte(secret),
+		keyID:           keyID,
+		keys:            map[string][]byte{keyID: []byte(secret)},
 		expirationHours: expirationHours,
 	}
 }
 
-func (m *JWTManager) GenerateToken(userID uint, email string) (string, error) {
+// AddVerificationKey 검증 전용 키 등록 (이전 키)
+func (m *JWTManager) AddVerificationKey(keyID, secret string) {
+	if keyID == m.keyID {
+		return
+	}
+	m.keys[keyID] = []byte(secret)
+}
+
+// GenerateToken access token 생성
+// familyID로 같은 로그인(기기 세션)에서 발급된 refresh token과 연결됩니다.
+// 역할은 발급 시점의 값이며, 변경 사항은 토큰 갱신 시 반영됩니다.
+func (m *JWTManager) GenerateToken(userID uint, email string, role Role, familyID string) (string, error) {
 	claims := JWTClaims{
-		UserID: userID,
-		Email:  email,
+		UserID:    userID,
+		Email:     email,
+		TokenType: TokenTypeAccess,
+		Role:      role,
+		FamilyID:  familyID,
 		RegisteredClaims: jwt.RegisteredClaims{
+			Audience:  jwt.ClaimStrings{AccessTokenAudience},
 			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour * time.Duration(m.expirationHours))),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
 		},
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString(m.secretKey)
+	return m.sign(claims)
 }
 
-func (m *JWTManager) GenerateRefreshToken(userID uint, email string) (string, error) {
+// GenerateRefreshToken jti가 포함된 refresh token 생성
+// familyID는 같은 로그인에서 로테이션된 토큰들을 묶는 식별자입니다.
+func (m *JWTManager) GenerateRefreshToken(userID uint, email, familyID string) (string, string, error) {
+	tokenID, err := NewTokenID()
+	if err != nil {
+		return "", "", err
+	}
+
 	claims := JWTClaims{
-		UserID: userID,
-		Email:  email,
+		UserID:    userID,
+		Email:     email,
+		TokenType: TokenTypeRefresh,
+		FamilyID:  familyID,
 		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour * 24 * 7)), // 7 days
+			ID:        tokenID,
+			Audience:  jwt.ClaimStrings{RefreshTokenAudience},
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(RefreshTokenDuration)),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
 		},
 	}
 
+	signed, err := m.sign(claims)
+	if err != nil {
+		return "", "", err
+	}
+	return signed, tokenID, nil
+}
+
+// ValidateAccessToken access token 검증 (refresh token은 거부)
+func (m *JWTManager) ValidateAccessToken(tokenString string) (*JWTClaims, error) {
+	return m.validate(tokenString, TokenTypeAccess, AccessTokenAudience)
+}
+
+// ValidateRefreshToken refresh token 검증 (access token은 거부)
+func (m *JWTManager) ValidateRefreshToken(tokenString string) (*JWTClaims, error) {
+	return m.validate(tokenString, TokenTypeRefresh, RefreshTokenAudience)
+}
+
+func (m *JWTManager) sign(claims JWTClaims) (string, error) {
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString(m.secretKey)
+	token.Header["kid"] = m.keyID
+	return token.SignedString(m.keys[m.keyID])
 }
 
-func (m *JWTManager) ValidateToken(tokenString string) (*JWTClaims, error) {
-	token, err := jwt.ParseWithClaims(tokenString, &JWTClaims{}, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, errors.New("unexpected signing method")
-		}
-		return m.secretKey, nil
-	})
+func (m *JWTManager) validate(tokenString string, tokenType TokenType, audience string) (*JWTClaims, error) {
+	token, err := jwt.ParseWithClaims(tokenString, &JWTClaims{}, m.keyFunc,
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithAudience(audience),
+	)
 
 	if err != nil {
 		return nil, err
 	}
 
-	if claims, ok := token.Claims.(*JWTClaims); ok && token.Valid {
-		return claims, nil
+	claims, ok := token.Claims.(*JWTClaims)
+	if !ok || !token.Valid {
+		return nil, errors.New("invalid token")
+	}
+
+	if claims.TokenType != tokenType {
+		return nil, fmt.Errorf("unexpected token type: %q", claims.TokenType)
 	}
 
-	return nil, errors.New("invalid token")
+	return claims, nil
+}
+
+// keyFunc kid 헤더로 검증 키 선택
+// kid가 없는 토큰(키 로테이션 도입 전에 발급된 토큰)은 현재 키로 검증합니다.
+func (m *JWTManager) keyFunc(token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+	if kid == "" {
+		return m.keys[m.keyID], nil
+	}
+
+	key, ok := m.keys[kid]
+	if !ok {
+		return nil, fmt.Errorf("unknown signing key: %s", kid)
+	}
+	return key, nil
+}
+
+// NewTokenID 토큰 식별자용 랜덤 문자열 생성 (128bit, hex)
+func NewTokenID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
 }
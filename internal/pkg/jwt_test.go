@@ -0,0 +1,147 @@
+package pkg
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// signWith 임의의 kid와 시크릿으로 서명한 토큰 (kid가 비어 있으면 헤더에서 뺌)
+func signWith(t *testing.T, kid, secret string, claims JWTClaims) string {
+	t.Helper()
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	if kid != "" {
+		token.Header["kid"] = kid
+	}
+	signed, err := token.SignedString([]byte(secret))
+	if err != nil {
+		t.Fatalf("SignedString() error = %v", err)
+	}
+	return signed
+}
+
+func accessClaims(userID uint) JWTClaims {
+	return JWTClaims{
+		UserID:    userID,
+		Email:     "user@example.com",
+		TokenType: TokenTypeAccess,
+		Role:      RoleUser,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Audience:  jwt.ClaimStrings{AccessTokenAudience},
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+		},
+	}
+}
+
+func TestJWTManagerKeySelection(t *testing.T) {
+	m := NewJWTManager("v2", "current-secret", 1)
+	m.AddVerificationKey("v1", "retired-secret")
+
+	tests := []struct {
+		name    string
+		token   string
+		wantErr bool
+	}{
+		{"current kid", signWith(t, "v2", "current-secret", accessClaims(1)), false},
+		{"retired kid", signWith(t, "v1", "retired-secret", accessClaims(1)), false},
+		{"missing kid uses current key", signWith(t, "", "current-secret", accessClaims(1)), false},
+		{"missing kid signed with retired key", signWith(t, "", "retired-secret", accessClaims(1)), true},
+		{"kid does not match secret", signWith(t, "v2", "retired-secret", accessClaims(1)), true},
+		{"unknown kid", signWith(t, "v0", "removed-secret", accessClaims(1)), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims, err := m.ValidateAccessToken(tt.token)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("ValidateAccessToken() error = nil, want error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ValidateAccessToken() error = %v", err)
+			}
+			if claims.UserID != 1 {
+				t.Errorf("UserID = %d, want 1", claims.UserID)
+			}
+		})
+	}
+}
+
+func TestJWTManagerRotation(t *testing.T) {
+	old := NewJWTManager("v1", "old-secret", 1)
+	access, err := old.GenerateToken(1, "user@example.com", RoleUser, "family")
+	if err != nil {
+		t.Fatalf("GenerateToken() error = %v", err)
+	}
+	refresh, _, err := old.GenerateRefreshToken(1, "user@example.com", "family")
+	if err != nil {
+		t.Fatalf("GenerateRefreshToken() error = %v", err)
+	}
+
+	// 새 키로 바꾸고 이전 키를 검증 전용으로 남긴 상태
+	rotated := NewJWTManager("v2", "new-secret", 1)
+	rotated.AddVerificationKey("v1", "old-secret")
+	if _, err := rotated.ValidateAccessToken(access); err != nil {
+		t.Errorf("access token signed with retired key: error = %v", err)
+	}
+	if _, err := rotated.ValidateRefreshToken(refresh); err != nil {
+		t.Errorf("refresh token signed with retired key: error = %v", err)
+	}
+
+	// 새로 발급한 토큰은 현재 키의 kid를 가짐
+	issued, err := rotated.GenerateToken(1, "user@example.com", RoleUser, "family")
+	if err != nil {
+		t.Fatalf("GenerateToken() error = %v", err)
+	}
+	token, _, err := jwt.NewParser().ParseUnverified(issued, &JWTClaims{})
+	if err != nil {
+		t.Fatalf("ParseUnverified() error = %v", err)
+	}
+	if kid := token.Header["kid"]; kid != "v2" {
+		t.Errorf("kid = %v, want v2", kid)
+	}
+
+	// 이전 키를 지우면 그 키로 서명된 토큰은 거부
+	removed := NewJWTManager("v2", "new-secret", 1)
+	if _, err := removed.ValidateAccessToken(access); err == nil {
+		t.Error("access token signed with removed key: want error")
+	}
+}
+
+func TestJWTManagerRejectsWrongTokenType(t *testing.T) {
+	m := NewJWTManager("v1", "secret", 1)
+
+	access, err := m.GenerateToken(1, "user@example.com", RoleUser, "family")
+	if err != nil {
+		t.Fatalf("GenerateToken() error = %v", err)
+	}
+	refresh, _, err := m.GenerateRefreshToken(1, "user@example.com", "family")
+	if err != nil {
+		t.Fatalf("GenerateRefreshToken() error = %v", err)
+	}
+
+	// audience는 access인데 token_type만 refresh인 토큰
+	mismatched := accessClaims(1)
+	mismatched.TokenType = TokenTypeRefresh
+
+	tests := []struct {
+		name     string
+		validate func(string) (*JWTClaims, error)
+		token    string
+	}{
+		{"refresh token as access token", m.ValidateAccessToken, refresh},
+		{"access token as refresh token", m.ValidateRefreshToken, access},
+		{"refresh token_type with access audience", m.ValidateAccessToken, signWith(t, "v1", "secret", mismatched)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := tt.validate(tt.token); err == nil {
+				t.Error("validate() error = nil, want error")
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package pkg
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"mime"
+	"net"
+	netmail "net/mail"
+	"net/smtp"
+	"strings"
+	"time"
+)
+
+// Mail 발송할 메일
+type Mail struct {
+	To      string
+	Subject string
+	Body    string // text/plain
+}
+
+// Mailer 메일 발송 인터페이스
+type Mailer interface {
+	Send(ctx context.Context, mail *Mail) error
+}
+
+// SMTPMailer SMTP 서버로 메일 발송
+// 로컬에서는 Mailpit/MailHog 같은 SMTP stand-in을 그대로 사용할 수 있습니다.
+type SMTPMailer struct {
+	addr         string
+	auth         smtp.Auth
+	from         string // From 헤더 ("jptaku <no-reply@jptaku.com>")
+	envelopeFrom string // MAIL FROM 주소
+}
+
+// NewSMTPMailer SMTPMailer 생성자 (username이 비어 있으면 인증 없이 발송)
+func NewSMTPMailer(host, port, username, password, from string) *SMTPMailer {
+	var auth smtp.Auth
+	if username != "" {
+		auth = smtp.PlainAuth("", username, password, host)
+	}
+	envelopeFrom := from
+	if addr, err := netmail.ParseAddress(from); err == nil {
+		envelopeFrom = addr.Address
+	}
+	return &SMTPMailer{
+		addr:         net.JoinHostPort(host, port),
+		auth:         auth,
+		from:         from,
+		envelopeFrom: envelopeFrom,
+	}
+}
+
+// Send 메일 발송
+func (m *SMTPMailer) Send(ctx context.Context, mail *Mail) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if err := smtp.SendMail(m.addr, m.auth, m.envelopeFrom, []string{mail.To}, m.buildMessage(mail)); err != nil {
+		return fmt.Errorf("failed to send mail to %s: %w", mail.To, err)
+	}
+	return nil
+}
+
+func (m *SMTPMailer) buildMessage(mail *Mail) []byte {
+	var b strings.Builder
+	b.WriteString("From: " + m.from + "\r\n")
+	b.WriteString("To: " + mail.To + "\r\n")
+	b.WriteString("Subject: " + mime.BEncoding.Encode("UTF-8", mail.Subject) + "\r\n")
+	b.WriteString("Date: " + time.Now().Format(time.RFC1123Z) + "\r\n")
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	b.WriteString("Content-Transfer-Encoding: 8bit\r\n")
+	b.WriteString("\r\n")
+	b.WriteString(strings.ReplaceAll(mail.Body, "\n", "\r\n"))
+	return []byte(b.String())
+}
+
+// LogMailer 메일을 발송하지 않고 로그로 출력 (SMTP 미설정 시 개발용)
+type LogMailer struct{}
+
+// NewLogMailer LogMailer 생성자
+func NewLogMailer() *LogMailer {
+	return &LogMailer{}
+}
+
+// Send 메일 내용을 로그로 출력
+func (m *LogMailer) Send(_ context.Context, mail *Mail) error {
+	log.Printf("[mail] to=%s subject=%q\n%s", mail.To, mail.Subject, mail.Body)
+	return nil
+}
@@ -6,68 +6,239 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"sort"
 
 	"golang.org/x/oauth2"
-	"golang.org/x/oauth2/google"
 )
 
-type GoogleOAuthManager struct {
-	config *oauth2.Config
+// OAuth 공급자 이름 (model.User.Provider 값, /api/auth/:provider 경로)
+const (
+	OAuthProviderGoogle = "google"
+	OAuthProviderKakao  = "kakao"
+	OAuthProviderLine   = "line"
+	OAuthProviderApple  = "apple"
+)
+
+// PlaceholderEmailDomain 이메일을 제공하지 않는 OAuth 계정에 부여하는 placeholder 이메일 도메인
+const PlaceholderEmailDomain = "users.jptaku.invalid"
+
+// OAuthEndpoints 공급자 엔드포인트
+// 비어 있는 항목은 공급자 기본값을 사용하며, 로컬 fake OAuth 서버로 교체할 때 지정합니다.
+type OAuthEndpoints struct {
+	AuthURL     string
+	TokenURL    string
+	UserInfoURL string
 }
 
-type GoogleUserInfo struct {
-	ID            string `json:"id"`
-	Email         string `json:"email"`
-	VerifiedEmail bool   `json:"verified_email"`
-	Name          string `json:"name"`
-	GivenName     string `json:"given_name"`
-	FamilyName    string `json:"family_name"`
-	Picture       string `json:"picture"`
+// OAuthProviderConfig 공급자 공통 설정
+type OAuthProviderConfig struct {
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+	Endpoints    OAuthEndpoints
+}
+
+// OAuthUserInfo 공급자별 프로필을 정규화한 사용자 정보
+type OAuthUserInfo struct {
+	Provider      string
+	ID            string
+	Email         string // 공급자가 제공하지 않으면 빈 값
+	EmailVerified bool
+	Name          string
+	Picture       string
+}
+
+// OAuthProvider OAuth 로그인 공급자
+type OAuthProvider interface {
+	Name() string
+	AuthCodeURL(state, verifier string) string
+	Exchange(ctx context.Context, code, verifier string) (*oauth2.Token, error)
+	UserInfo(ctx context.Context, token *oauth2.Token) (*OAuthUserInfo, error)
+}
+
+// OAuthRegistry 이름으로 OAuth 공급자를 찾는 레지스트리
+type OAuthRegistry struct {
+	providers map[string]OAuthProvider
+}
+
+// NewOAuthRegistry OAuthRegistry 생성자
+func NewOAuthRegistry(providers ...OAuthProvider) *OAuthRegistry {
+	r := &OAuthRegistry{providers: make(map[string]OAuthProvider)}
+	for _, p := range providers {
+		r.Register(p)
+	}
+	return r
+}
+
+// Register 공급자 등록 (같은 이름이면 교체)
+func (r *OAuthRegistry) Register(provider OAuthProvider) {
+	r.providers[provider.Name()] = provider
+}
+
+// Get 이름으로 공급자 조회
+func (r *OAuthRegistry) Get(name string) (OAuthProvider, error) {
+	provider, ok := r.providers[name]
+	if !ok {
+		return nil, ErrUnknownOAuthProvider
+	}
+	return provider, nil
+}
+
+// Names 등록된 공급자 이름 목록 (정렬됨)
+func (r *OAuthRegistry) Names() []string {
+	names := make([]string, 0, len(r.providers))
+	for name := range r.providers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ========================================
+// 표준 authorization code 플로우 공통 구현
+// ========================================
+
+type oauth2Provider struct {
+	name        string
+	config      *oauth2.Config
+	userInfoURL string
+	pkce        bool
+	authOptions []oauth2.AuthCodeOption
 }
 
-func NewGoogleOAuthManager(clientID, clientSecret, redirectURL string) *GoogleOAuthManager {
-	config := &oauth2.Config{
-		ClientID:     clientID,
-		ClientSecret: clientSecret,
-		RedirectURL:  redirectURL,
-		Scopes: []string{
-			"https://www.googleapis.com/auth/userinfo.email",
-			"https://www.googleapis.com/auth/userinfo.profile",
+func newOAuth2Provider(name string, cfg OAuthProviderConfig, defaults OAuthEndpoints, scopes []string, authStyle oauth2.AuthStyle) oauth2Provider {
+	endpoints := cfg.Endpoints.withDefaults(defaults)
+	return oauth2Provider{
+		name: name,
+		config: &oauth2.Config{
+			ClientID:     cfg.ClientID,
+			ClientSecret: cfg.ClientSecret,
+			RedirectURL:  cfg.RedirectURL,
+			Scopes:       scopes,
+			Endpoint: oauth2.Endpoint{
+				AuthURL:   endpoints.AuthURL,
+				TokenURL:  endpoints.TokenURL,
+				AuthStyle: authStyle,
+			},
 		},
-		Endpoint: google.Endpoint,
+		userInfoURL: endpoints.UserInfoURL,
+		pkce:        true,
 	}
+}
 
-	return &GoogleOAuthManager{
-		config: config,
+// withDefaults 비어 있는 엔드포인트를 기본값으로 채움
+func (e OAuthEndpoints) withDefaults(defaults OAuthEndpoints) OAuthEndpoints {
+	if e.AuthURL == "" {
+		e.AuthURL = defaults.AuthURL
+	}
+	if e.TokenURL == "" {
+		e.TokenURL = defaults.TokenURL
 	}
+	if e.UserInfoURL == "" {
+		e.UserInfoURL = defaults.UserInfoURL
+	}
+	return e
 }
 
-func (m *GoogleOAuthManager) GetAuthURL(state string) string {
-	return m.config.AuthCodeURL(state, oauth2.AccessTypeOffline)
+// Name 공급자 이름
+func (p *oauth2Provider) Name() string {
+	return p.name
 }
 
-func (m *GoogleOAuthManager) Exchange(ctx context.Context, code string) (*oauth2.Token, error) {
-	return m.config.Exchange(ctx, code)
+// AuthCodeURL 인증 URL 생성 (PKCE 지원 공급자는 code_challenge(S256) 포함)
+func (p *oauth2Provider) AuthCodeURL(state, verifier string) string {
+	opts := append([]oauth2.AuthCodeOption{}, p.authOptions...)
+	if p.pkce {
+		opts = append(opts, oauth2.S256ChallengeOption(verifier))
+	}
+	return p.config.AuthCodeURL(state, opts...)
+}
+
+// Exchange 인증 코드를 토큰으로 교환 (PKCE 지원 공급자는 code_verifier 포함)
+func (p *oauth2Provider) Exchange(ctx context.Context, code, verifier string) (*oauth2.Token, error) {
+	var opts []oauth2.AuthCodeOption
+	if p.pkce {
+		opts = append(opts, oauth2.VerifierOption(verifier))
+	}
+	return p.config.Exchange(ctx, code, opts...)
 }
 
-func (m *GoogleOAuthManager) GetUserInfo(ctx context.Context, token *oauth2.Token) (*GoogleUserInfo, error) {
-	client := m.config.Client(ctx, token)
+// fetchJSON access token으로 userinfo 엔드포인트 조회
+func (p *oauth2Provider) fetchJSON(ctx context.Context, token *oauth2.Token, out interface{}) error {
+	client := p.config.Client(ctx, token)
 
-	resp, err := client.Get("https://www.googleapis.com/oauth2/v2/userinfo")
+	resp, err := client.Get(p.userInfoURL)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get user info: %w", err)
+		return fmt.Errorf("failed to get user info: %w", err)
 	}
 	defer resp.Body.Close()
 
+	return decodeJSONResponse(resp, out)
+}
+
+// decodeJSONResponse 200 응답이면 JSON 디코딩, 아니면 본문을 포함한 에러 반환
+func decodeJSONResponse(resp *http.Response, out interface{}) error {
 	if resp.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("failed to get user info: status %d, body: %s", resp.StatusCode, string(body))
+		return fmt.Errorf("failed to get user info: status %d, body: %s", resp.StatusCode, string(body))
 	}
 
-	var userInfo GoogleUserInfo
-	if err := json.NewDecoder(resp.Body).Decode(&userInfo); err != nil {
-		return nil, fmt.Errorf("failed to decode user info: %w", err)
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode user info: %w", err)
+	}
+	return nil
+}
+
+// ========================================
+// Google
+// ========================================
+
+// GoogleEndpoints Google 기본 엔드포인트
+var GoogleEndpoints = OAuthEndpoints{
+	AuthURL:     "https://accounts.google.com/o/oauth2/auth",
+	TokenURL:    "https://oauth2.googleapis.com/token",
+	UserInfoURL: "https://www.googleapis.com/oauth2/v2/userinfo",
+}
+
+// GoogleProvider Google OAuth 공급자
+type GoogleProvider struct {
+	oauth2Provider
+}
+
+type googleUserInfo struct {
+	ID            string `json:"id"`
+	Email         string `json:"email"`
+	VerifiedEmail bool   `json:"verified_email"`
+	Name          string `json:"name"`
+	Picture       string `json:"picture"`
+}
+
+// NewGoogleProvider GoogleProvider 생성자
+func NewGoogleProvider(cfg OAuthProviderConfig) *GoogleProvider {
+	p := newOAuth2Provider(OAuthProviderGoogle, cfg, GoogleEndpoints, []string{
+		"https://www.googleapis.com/auth/userinfo.email",
+		"https://www.googleapis.com/auth/userinfo.profile",
+	}, oauth2.AuthStyleInParams)
+	p.authOptions = []oauth2.AuthCodeOption{oauth2.AccessTypeOffline}
+	return &GoogleProvider{oauth2Provider: p}
+}
+
+// UserInfo Google 프로필 조회
+func (p *GoogleProvider) UserInfo(ctx context.Context, token *oauth2.Token) (*OAuthUserInfo, error) {
+	var info googleUserInfo
+	if err := p.fetchJSON(ctx, token, &info); err != nil {
+		return nil, err
+	}
+	if info.ID == "" {
+		return nil, fmt.Errorf("google user info has no id")
 	}
 
-	return &userInfo, nil
+	return &OAuthUserInfo{
+		Provider:      OAuthProviderGoogle,
+		ID:            info.ID,
+		Email:         info.Email,
+		EmailVerified: info.VerifiedEmail,
+		Name:          info.Name,
+		Picture:       info.Picture,
+	}, nil
 }
@@ -0,0 +1,235 @@
+package pkg
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"golang.org/x/oauth2"
+)
+
+// AppleEndpoints Apple 기본 엔드포인트
+// Apple은 userinfo 엔드포인트가 없고, UserInfoURL에는 id_token 서명 검증용 JWKS URL을 사용합니다.
+var AppleEndpoints = OAuthEndpoints{
+	AuthURL:     "https://appleid.apple.com/auth/authorize",
+	TokenURL:    "https://appleid.apple.com/auth/token",
+	UserInfoURL: "https://appleid.apple.com/auth/keys",
+}
+
+// AppleIssuer Apple id_token의 iss 값
+const AppleIssuer = "https://appleid.apple.com"
+
+// appleKeysCacheTTL JWKS 캐시 유지 시간
+const appleKeysCacheTTL = time.Hour
+
+// AppleProviderConfig Sign in with Apple 설정
+// ClientSecret 대신 .p8 개인 키로 매 요청마다 client_secret JWT를 만듭니다.
+type AppleProviderConfig struct {
+	OAuthProviderConfig
+	TeamID     string
+	KeyID      string
+	PrivateKey string // .p8 PEM 내용
+	Issuer     string // 비어 있으면 AppleIssuer (fake 서버 테스트용)
+}
+
+// AppleProvider Sign in with Apple 공급자
+type AppleProvider struct {
+	oauth2Provider
+	teamID     string
+	keyID      string
+	issuer     string
+	privateKey *ecdsa.PrivateKey
+
+	mu        sync.Mutex
+	keys      map[string]*rsa.PublicKey
+	keysFetch time.Time
+}
+
+type appleIDTokenClaims struct {
+	Email         string   `json:"email"`
+	EmailVerified flexBool `json:"email_verified"`
+	jwt.RegisteredClaims
+}
+
+// flexBool Apple은 bool 값을 "true" 문자열로 보내기도 합니다.
+type flexBool bool
+
+func (b *flexBool) UnmarshalJSON(data []byte) error {
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	switch t := v.(type) {
+	case bool:
+		*b = flexBool(t)
+	case string:
+		*b = flexBool(t == "true")
+	}
+	return nil
+}
+
+type appleJWKS struct {
+	Keys []struct {
+		Kid string `json:"kid"`
+		Kty string `json:"kty"`
+		N   string `json:"n"`
+		E   string `json:"e"`
+	} `json:"keys"`
+}
+
+// NewAppleProvider AppleProvider 생성자
+// 이름/이메일 scope를 요청하므로 콜백은 form_post(POST)로 돌아옵니다.
+func NewAppleProvider(cfg AppleProviderConfig) (*AppleProvider, error) {
+	privateKey, err := jwt.ParseECPrivateKeyFromPEM([]byte(cfg.PrivateKey))
+	if err != nil {
+		return nil, fmt.Errorf("invalid apple private key: %w", err)
+	}
+
+	issuer := cfg.Issuer
+	if issuer == "" {
+		issuer = AppleIssuer
+	}
+
+	p := newOAuth2Provider(OAuthProviderApple, cfg.OAuthProviderConfig, AppleEndpoints, []string{
+		"name",
+		"email",
+	}, oauth2.AuthStyleInParams)
+	// Apple 토큰 엔드포인트는 PKCE를 지원하지 않습니다. (state nonce + client_secret으로 보호)
+	p.pkce = false
+	p.authOptions = []oauth2.AuthCodeOption{oauth2.SetAuthURLParam("response_mode", "form_post")}
+
+	return &AppleProvider{
+		oauth2Provider: p,
+		teamID:         cfg.TeamID,
+		keyID:          cfg.KeyID,
+		issuer:         issuer,
+		privateKey:     privateKey,
+	}, nil
+}
+
+// Exchange client_secret JWT를 만들어 인증 코드를 토큰으로 교환
+func (p *AppleProvider) Exchange(ctx context.Context, code, _ string) (*oauth2.Token, error) {
+	secret, err := p.clientSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	config := *p.config
+	config.ClientSecret = secret
+	return config.Exchange(ctx, code)
+}
+
+// clientSecret ES256으로 서명한 client_secret 생성
+func (p *AppleProvider) clientSecret() (string, error) {
+	now := time.Now()
+	claims := jwt.RegisteredClaims{
+		Issuer:    p.teamID,
+		Subject:   p.config.ClientID,
+		Audience:  jwt.ClaimStrings{p.issuer},
+		IssuedAt:  jwt.NewNumericDate(now),
+		ExpiresAt: jwt.NewNumericDate(now.Add(5 * time.Minute)),
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodES256, claims)
+	token.Header["kid"] = p.keyID
+	return token.SignedString(p.privateKey)
+}
+
+// UserInfo 토큰 응답의 id_token 서명/발급자/대상을 검증해 사용자 정보 추출
+func (p *AppleProvider) UserInfo(ctx context.Context, token *oauth2.Token) (*OAuthUserInfo, error) {
+	idToken, _ := token.Extra("id_token").(string)
+	if idToken == "" {
+		return nil, fmt.Errorf("apple token response has no id_token")
+	}
+
+	claims := &appleIDTokenClaims{}
+	_, err := jwt.ParseWithClaims(idToken, claims, func(t *jwt.Token) (interface{}, error) {
+		kid, _ := t.Header["kid"].(string)
+		return p.publicKey(ctx, kid)
+	},
+		jwt.WithValidMethods([]string{jwt.SigningMethodRS256.Alg()}),
+		jwt.WithIssuer(p.issuer),
+		jwt.WithAudience(p.config.ClientID),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("invalid apple id token: %w", err)
+	}
+	if claims.Subject == "" {
+		return nil, fmt.Errorf("apple id token has no sub")
+	}
+
+	// 이름은 최초 로그인 시 form의 user 필드로만 전달되므로 여기서는 비워 둡니다.
+	return &OAuthUserInfo{
+		Provider:      OAuthProviderApple,
+		ID:            claims.Subject,
+		Email:         claims.Email,
+		EmailVerified: bool(claims.EmailVerified),
+	}, nil
+}
+
+// publicKey kid에 해당하는 Apple 공개 키 조회 (모르는 kid면 JWKS 재조회)
+func (p *AppleProvider) publicKey(ctx context.Context, kid string) (*rsa.PublicKey, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if key, ok := p.keys[kid]; ok && time.Since(p.keysFetch) < appleKeysCacheTTL {
+		return key, nil
+	}
+
+	keys, err := p.fetchKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+	p.keys = keys
+	p.keysFetch = time.Now()
+
+	key, ok := keys[kid]
+	if !ok {
+		return nil, fmt.Errorf("unknown apple key id: %s", kid)
+	}
+	return key, nil
+}
+
+// fetchKeys Apple JWKS 조회
+func (p *AppleProvider) fetchKeys(ctx context.Context) (map[string]*rsa.PublicKey, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.userInfoURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch apple keys: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var jwks appleJWKS
+	if err := decodeJSONResponse(resp, &jwks); err != nil {
+		return nil, err
+	}
+
+	keys := make(map[string]*rsa.PublicKey, len(jwks.Keys))
+	for _, k := range jwks.Keys {
+		if k.Kty != "RSA" {
+			continue
+		}
+		n, errN := base64.RawURLEncoding.DecodeString(k.N)
+		e, errE := base64.RawURLEncoding.DecodeString(k.E)
+		if errN != nil || errE != nil {
+			continue
+		}
+		keys[k.Kid] = &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}
+	}
+	return keys, nil
+}
@@ -0,0 +1,67 @@
+package pkg
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"golang.org/x/oauth2"
+)
+
+// KakaoEndpoints Kakao 기본 엔드포인트
+var KakaoEndpoints = OAuthEndpoints{
+	AuthURL:     "https://kauth.kakao.com/oauth/authorize",
+	TokenURL:    "https://kauth.kakao.com/oauth/token",
+	UserInfoURL: "https://kapi.kakao.com/v2/user/me",
+}
+
+// KakaoProvider Kakao OAuth 공급자
+type KakaoProvider struct {
+	oauth2Provider
+}
+
+type kakaoUserInfo struct {
+	ID           int64 `json:"id"`
+	KakaoAccount struct {
+		Email           string `json:"email"`
+		IsEmailValid    bool   `json:"is_email_valid"`
+		IsEmailVerified bool   `json:"is_email_verified"`
+		Profile         struct {
+			Nickname        string `json:"nickname"`
+			ProfileImageURL string `json:"profile_image_url"`
+		} `json:"profile"`
+	} `json:"kakao_account"`
+}
+
+// NewKakaoProvider KakaoProvider 생성자
+// Kakao는 client_secret을 요청 본문으로 받습니다.
+func NewKakaoProvider(cfg OAuthProviderConfig) *KakaoProvider {
+	p := newOAuth2Provider(OAuthProviderKakao, cfg, KakaoEndpoints, []string{
+		"profile_nickname",
+		"profile_image",
+		"account_email",
+	}, oauth2.AuthStyleInParams)
+	return &KakaoProvider{oauth2Provider: p}
+}
+
+// UserInfo Kakao 프로필 조회
+// 이메일은 사용자가 동의하지 않으면 비어 있습니다.
+func (p *KakaoProvider) UserInfo(ctx context.Context, token *oauth2.Token) (*OAuthUserInfo, error) {
+	var info kakaoUserInfo
+	if err := p.fetchJSON(ctx, token, &info); err != nil {
+		return nil, err
+	}
+	if info.ID == 0 {
+		return nil, fmt.Errorf("kakao user info has no id")
+	}
+
+	account := info.KakaoAccount
+	return &OAuthUserInfo{
+		Provider:      OAuthProviderKakao,
+		ID:            strconv.FormatInt(info.ID, 10),
+		Email:         account.Email,
+		EmailVerified: account.Email != "" && account.IsEmailValid && account.IsEmailVerified,
+		Name:          account.Profile.Nickname,
+		Picture:       account.Profile.ProfileImageURL,
+	}, nil
+}
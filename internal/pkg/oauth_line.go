@@ -0,0 +1,83 @@
+package pkg
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"golang.org/x/oauth2"
+)
+
+// LineEndpoints LINE 기본 엔드포인트
+// UserInfoURL은 ID 토큰 검증 엔드포인트이며, 검증 결과에 프로필과 이메일이 포함됩니다.
+var LineEndpoints = OAuthEndpoints{
+	AuthURL:     "https://access.line.me/oauth2/v2.1/authorize",
+	TokenURL:    "https://api.line.me/oauth2/v2.1/token",
+	UserInfoURL: "https://api.line.me/oauth2/v2.1/verify",
+}
+
+// LineProvider LINE Login 공급자
+type LineProvider struct {
+	oauth2Provider
+}
+
+type lineIDTokenInfo struct {
+	Sub     string `json:"sub"`
+	Name    string `json:"name"`
+	Picture string `json:"picture"`
+	Email   string `json:"email"`
+}
+
+// NewLineProvider LineProvider 생성자
+// ClientID/ClientSecret에는 LINE Login 채널 ID/시크릿을 사용합니다.
+func NewLineProvider(cfg OAuthProviderConfig) *LineProvider {
+	p := newOAuth2Provider(OAuthProviderLine, cfg, LineEndpoints, []string{
+		"profile",
+		"openid",
+		"email",
+	}, oauth2.AuthStyleInParams)
+	return &LineProvider{oauth2Provider: p}
+}
+
+// UserInfo 토큰 응답의 id_token을 LINE에 검증 요청해 프로필 조회
+func (p *LineProvider) UserInfo(ctx context.Context, token *oauth2.Token) (*OAuthUserInfo, error) {
+	idToken, _ := token.Extra("id_token").(string)
+	if idToken == "" {
+		return nil, fmt.Errorf("line token response has no id_token")
+	}
+
+	form := url.Values{
+		"id_token":  {idToken},
+		"client_id": {p.config.ClientID},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.userInfoURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify id token: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var info lineIDTokenInfo
+	if err := decodeJSONResponse(resp, &info); err != nil {
+		return nil, err
+	}
+	if info.Sub == "" {
+		return nil, fmt.Errorf("line id token has no sub")
+	}
+
+	return &OAuthUserInfo{
+		Provider:      OAuthProviderLine,
+		ID:            info.Sub,
+		Email:         info.Email,
+		EmailVerified: info.Email != "",
+		Name:          info.Name,
+		Picture:       info.Picture,
+	}, nil
+}
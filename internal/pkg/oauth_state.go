@@ -0,0 +1,163 @@
+package pkg
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"time"
+)
+
+// OAuth 로그인을 시작한 클라이언트 종류
+const (
+	OAuthClientWeb    = "web"
+	OAuthClientMobile = "mobile"
+)
+
+// OAuthStateTTL OAuth state 유효 시간
+const OAuthStateTTL = 10 * time.Minute
+
+// LoginCodeTTL 콜백에서 발급하는 1회용 로그인 코드 유효 시간
+const LoginCodeTTL = 2 * time.Minute
+
+// MergeTicketTTL 계정 병합 확인 티켓 유효 시간
+const MergeTicketTTL = 10 * time.Minute
+
+// PKCEMethodS256 지원하는 code_challenge_method
+const PKCEMethodS256 = "S256"
+
+// OAuthState 서버가 발급하는 OAuth state 내용
+// Nonce는 Redis에 저장된 PKCE verifier를 찾는 키이며 한 번만 사용할 수 있습니다.
+// CodeChallenge는 클라이언트(앱)가 보낸 값으로, 로그인 코드 교환 시 본인 확인에 사용합니다.
+type OAuthState struct {
+	Nonce         string `json:"n"`
+	Provider      string `json:"p"`
+	ClientType    string `json:"c"`
+	Redirect      string `json:"r,omitempty"`
+	CodeChallenge string `json:"cc,omitempty"`
+	LinkUserID    uint   `json:"u,omitempty"` // 0이 아니면 로그인 대신 해당 사용자에 identity 연결
+	IssuedAt      int64  `json:"t"`
+}
+
+// IsMobile 모바일 앱에서 시작한 로그인인지 여부
+func (s *OAuthState) IsMobile() bool {
+	return s.ClientType == OAuthClientMobile
+}
+
+// OAuthStateManager OAuth state 발급/서명/검증
+type OAuthStateManager struct {
+	secret           []byte
+	allowedRedirects map[string]bool
+	defaultRedirects map[string]string
+}
+
+// NewOAuthStateManager OAuthStateManager 생성자
+// allowedRedirects에 포함된 URI만 redirect 대상으로 사용할 수 있습니다.
+func NewOAuthStateManager(secret string, allowedRedirects []string, mobileRedirect string) *OAuthStateManager {
+	allowed := make(map[string]bool, len(allowedRedirects)+1)
+	for _, uri := range allowedRedirects {
+		if uri = strings.TrimSpace(uri); uri != "" {
+			allowed[uri] = true
+		}
+	}
+	allowed[mobileRedirect] = true
+
+	return &OAuthStateManager{
+		secret:           []byte(secret),
+		allowedRedirects: allowed,
+		defaultRedirects: map[string]string{OAuthClientMobile: mobileRedirect},
+	}
+}
+
+// New 새 state 생성 (clientType/redirect 검증 포함)
+// state는 발급한 provider의 콜백에서만 사용할 수 있습니다.
+// redirect로 돌아가는 플로우는 로그인 코드를 URL로 전달하므로 클라이언트의 code_challenge가 필수입니다.
+func (m *OAuthStateManager) New(provider, clientType, redirect, codeChallenge, challengeMethod string) (*OAuthState, error) {
+	if clientType == "" {
+		clientType = OAuthClientWeb
+	}
+	if clientType != OAuthClientWeb && clientType != OAuthClientMobile {
+		return nil, ErrInvalidOAuthClient
+	}
+
+	if redirect == "" {
+		redirect = m.defaultRedirects[clientType]
+	}
+	if redirect != "" && !m.allowedRedirects[redirect] {
+		return nil, ErrRedirectNotAllowed
+	}
+
+	if challengeMethod != "" && challengeMethod != PKCEMethodS256 {
+		return nil, ErrPKCERequired
+	}
+	if redirect != "" && codeChallenge == "" {
+		return nil, ErrPKCERequired
+	}
+
+	nonce, err := NewTokenID()
+	if err != nil {
+		return nil, err
+	}
+
+	return &OAuthState{
+		Nonce:         nonce,
+		Provider:      provider,
+		ClientType:    clientType,
+		Redirect:      redirect,
+		CodeChallenge: codeChallenge,
+		IssuedAt:      time.Now().Unix(),
+	}, nil
+}
+
+// Encode state를 "payload.signature" 형식의 문자열로 변환
+func (m *OAuthStateManager) Encode(state *OAuthState) (string, error) {
+	payload, err := json.Marshal(state)
+	if err != nil {
+		return "", err
+	}
+
+	encoded := base64.RawURLEncoding.EncodeToString(payload)
+	return encoded + "." + m.sign(encoded), nil
+}
+
+// Decode 서명과 만료를 확인하고 state를 복원
+func (m *OAuthStateManager) Decode(raw string) (*OAuthState, error) {
+	encoded, signature, ok := strings.Cut(raw, ".")
+	if !ok || !hmac.Equal([]byte(signature), []byte(m.sign(encoded))) {
+		return nil, ErrInvalidOAuthState
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, ErrInvalidOAuthState
+	}
+
+	var state OAuthState
+	if err := json.Unmarshal(payload, &state); err != nil || state.Nonce == "" {
+		return nil, ErrInvalidOAuthState
+	}
+
+	if time.Since(time.Unix(state.IssuedAt, 0)) > OAuthStateTTL {
+		return nil, ErrInvalidOAuthState
+	}
+
+	return &state, nil
+}
+
+func (m *OAuthStateManager) sign(encoded string) string {
+	mac := hmac.New(sha256.New, m.secret)
+	mac.Write([]byte(encoded))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// VerifyPKCE code_verifier가 S256 code_challenge와 일치하는지 확인
+func VerifyPKCE(verifier, challenge string) bool {
+	if verifier == "" || challenge == "" {
+		return false
+	}
+	sum := sha256.Sum256([]byte(verifier))
+	computed := base64.RawURLEncoding.EncodeToString(sum[:])
+	return subtle.ConstantTimeCompare([]byte(computed), []byte(challenge)) == 1
+}
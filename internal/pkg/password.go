@@ -0,0 +1,47 @@
+package pkg
+
+import (
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// LocalAuthProvider 이메일/비밀번호 계정의 provider 값
+const LocalAuthProvider = "email"
+
+// 이메일 토큰 용도
+const (
+	EmailTokenVerify = "verify"
+	EmailTokenReset  = "reset"
+)
+
+// 이메일 토큰 유효 시간
+const (
+	EmailVerifyTokenTTL   = 24 * time.Hour
+	PasswordResetTokenTTL = time.Hour
+)
+
+// maxPasswordBytes bcrypt가 처리할 수 있는 최대 길이
+const maxPasswordBytes = 72
+
+// dummyPasswordHash 존재하지 않는 계정 로그인 시에도 같은 비용의 비교를 하기 위한 해시
+var dummyPasswordHash, _ = bcrypt.GenerateFromPassword([]byte("jptaku-dummy-password"), bcrypt.DefaultCost)
+
+// HashPassword 비밀번호 bcrypt 해시 생성
+func HashPassword(password string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return "", err
+	}
+	return string(hash), nil
+}
+
+// CheckPassword 비밀번호가 해시와 일치하는지 확인
+// hash가 비어 있으면(비밀번호 미설정 계정) 더미 해시와 비교해 응답 시간을 맞추고 false를 반환합니다.
+func CheckPassword(hash, password string) bool {
+	if hash == "" {
+		bcrypt.CompareHashAndPassword(dummyPasswordHash, []byte(password))
+		return false
+	}
+	return bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)) == nil
+}
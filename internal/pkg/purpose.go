@@ -0,0 +1,31 @@
+package pkg
+
+// purposeSubCategories 학습 목적별로 관련이 깊은 SubCategory (문장 추천 가중치용)
+var purposeSubCategories = map[Purpose][]SubCategory{
+	PurposeWatchAnime: {
+		SubCategoryAnimeBattleFantasySF, SubCategoryAnimeSliceLoveEmo, SubCategoryAnimeStoryMystery,
+		SubCategoryMusicAnime,
+	},
+	PurposeTalkFriends: {
+		SubCategorySitOtakuTalk, SubCategorySitOnsiteLiveGreeting,
+	},
+	PurposeTravel: {
+		SubCategoryLifePilgrimageTravel, SubCategorySitShoppingOrder, SubCategorySitCollabCafeGameCtr,
+	},
+	PurposeVtuber: {
+		SubCategoryVtuber,
+	},
+	PurposeGame: {
+		SubCategoryGameRpgGacha, SubCategoryGameRhythm, SubCategoryGameActionVsShoo,
+		SubCategorySitCollabCafeGameCtr,
+	},
+	PurposeGoodsEvent: {
+		SubCategoryLifeGoodsCollect, SubCategoryLifeComiketDoujin,
+		SubCategorySitShoppingOrder, SubCategorySitOnsiteLiveGreeting,
+	},
+}
+
+// SubCategories 학습 목적과 관련이 깊은 SubCategory 목록 (기타는 없음)
+func (p Purpose) SubCategories() []SubCategory {
+	return purposeSubCategories[p]
+}
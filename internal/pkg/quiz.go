@@ -0,0 +1,63 @@
+package pkg
+
+import (
+	crand "crypto/rand"
+	"encoding/binary"
+	"hash/fnv"
+	"math/rand/v2"
+)
+
+// QuizType 퀴즈 문제 유형 (model.Quiz의 필드, 채점기 키)
+type QuizType string
+
+const (
+	QuizTypeFillBlank QuizType = "fill_blank" // 빈칸 채우기
+	QuizTypeOrdering  QuizType = "ordering"   // 문장 배열하기
+	QuizTypeMeaning   QuizType = "meaning"    // 한국어 뜻 고르기
+	QuizTypeListening QuizType = "listening"  // 음성을 듣고 문장 고르기
+	QuizTypeDictation QuizType = "dictation"  // 음성을 듣고 받아쓰기
+	QuizTypeReading   QuizType = "reading"    // 단어 읽기 (가나 입력)
+)
+
+// AllQuizTypes 모든 퀴즈 유형 (채점/응답 순서)
+var AllQuizTypes = []QuizType{
+	QuizTypeFillBlank,
+	QuizTypeOrdering,
+	QuizTypeMeaning,
+	QuizTypeListening,
+	QuizTypeDictation,
+	QuizTypeReading,
+}
+
+// IsValid 정의된 퀴즈 유형인지
+func (t QuizType) IsValid() bool {
+	for _, v := range AllQuizTypes {
+		if t == v {
+			return true
+		}
+	}
+	return false
+}
+
+// NewQuizSeed 사용자별 퀴즈 시드 생성 (0이 아닌 랜덤 값, 0은 미발급을 뜻함)
+func NewQuizSeed() (int64, error) {
+	var b [8]byte
+	for {
+		if _, err := crand.Read(b[:]); err != nil {
+			return 0, err
+		}
+		if seed := int64(binary.LittleEndian.Uint64(b[:])); seed != 0 {
+			return seed, nil
+		}
+	}
+}
+
+// QuizPermutation 사용자 시드와 문장 ID, 문제 유형으로 정해지는 보기 순서
+// i번째 원소는 화면의 i번째 자리에 보여줄 원래 인덱스입니다. 같은 입력이면 항상 같은 순서가 나오므로
+// 보여줄 때와 채점할 때 같은 순서를 다시 계산할 수 있습니다.
+func QuizPermutation(seed int64, sentenceID uint, quizType QuizType, n int) []int {
+	h := fnv.New64a()
+	h.Write([]byte(quizType))
+	r := rand.New(rand.NewPCG(uint64(seed), h.Sum64()^uint64(sentenceID)))
+	return r.Perm(n)
+}
@@ -0,0 +1,35 @@
+package pkg
+
+import (
+	"fmt"
+	"time"
+)
+
+// 요청 제한을 적용하는 라우트 그룹
+const (
+	RateLimitAuth      = "auth"
+	RateLimitChat      = "chat"
+	RateLimitSentences = "sentences"
+	RateLimitAudio     = "audio"
+)
+
+// RateLimitPolicy 라우트 그룹별 요청 제한 (Window 동안 최대 Limit회)
+type RateLimitPolicy struct {
+	Name   string
+	Limit  int
+	Window time.Duration
+}
+
+// String RateLimit-Policy 헤더 값 (예: "20;w=60")
+func (p RateLimitPolicy) String() string {
+	return fmt.Sprintf("%d;w=%d", p.Limit, int(p.Window.Seconds()))
+}
+
+// RateLimitResult 요청 한 건에 대한 제한 판정 결과
+// Reset은 가장 오래된 요청이 윈도우에서 빠져 한 자리가 비기까지 남은 시간입니다.
+type RateLimitResult struct {
+	Allowed   bool
+	Limit     int
+	Remaining int
+	Reset     time.Duration
+}
@@ -0,0 +1,50 @@
+package pkg
+
+// Role 사용자 역할 (model.User.Role)
+type Role string
+
+const (
+	RoleUser     Role = "user"     // 일반 사용자
+	RoleOperator Role = "operator" // 콘텐츠/운영 담당
+	RoleAdmin    Role = "admin"    // 전체 관리자
+)
+
+// Permission 관리 기능 권한
+type Permission string
+
+const (
+	PermissionManageContent Permission = "content:write" // 문장/퀴즈 등 콘텐츠 관리
+	PermissionViewOps       Permission = "ops:read"      // 운영 지표/사용자 조회
+	PermissionManageUsers   Permission = "users:manage"  // 사용자 역할 변경 등
+)
+
+// rolePermissions 역할별 권한
+var rolePermissions = map[Role][]Permission{
+	RoleOperator: {PermissionManageContent, PermissionViewOps},
+	RoleAdmin:    {PermissionManageContent, PermissionViewOps, PermissionManageUsers},
+}
+
+// IsValid 정의된 역할인지 확인
+func (r Role) IsValid() bool {
+	switch r {
+	case RoleUser, RoleOperator, RoleAdmin:
+		return true
+	default:
+		return false
+	}
+}
+
+// Can 역할에 권한이 있는지 확인
+func (r Role) Can(permission Permission) bool {
+	for _, p := range rolePermissions[r] {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
+
+// Permissions 역할의 권한 목록
+func (r Role) Permissions() []Permission {
+	return rolePermissions[r]
+}
@@ -0,0 +1,42 @@
+package pkg
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// NormalizeSearchText 검색용 텍스트 정규화
+// NFKC로 반각/전각을 통일하고(ｶﾞﾁｬ → ガチャ, ＡＢＣ → ABC) 소문자로 바꾼 뒤
+// 가타카나를 히라가나로 접어(ガチャ → がちゃ) 표기가 달라도 같은 문자열로 비교할 수 있게 합니다.
+func NormalizeSearchText(s string) string {
+	s = strings.ToLower(norm.NFKC.String(s))
+
+	var b strings.Builder
+	b.Grow(len(s))
+	space := false
+	for _, r := range s {
+		if unicode.IsSpace(r) {
+			space = b.Len() > 0
+			continue
+		}
+		if space {
+			b.WriteByte(' ')
+			space = false
+		}
+		b.WriteRune(toHiragana(r))
+	}
+	return b.String()
+}
+
+// BuildSearchText 여러 필드를 정규화해 검색 문서 하나로 합침 (필드 사이는 줄바꿈)
+func BuildSearchText(fields ...string) string {
+	parts := make([]string, 0, len(fields))
+	for _, field := range fields {
+		if normalized := NormalizeSearchText(field); normalized != "" {
+			parts = append(parts, normalized)
+		}
+	}
+	return strings.Join(parts, "\n")
+}
@@ -0,0 +1,86 @@
+package pkg
+
+import (
+	"math"
+	"time"
+)
+
+// ReviewGrade 복습 평가 (SM-2의 응답 품질 q, 0~5)
+type ReviewGrade int
+
+const (
+	ReviewAgain ReviewGrade = 1 // 기억하지 못함
+	ReviewHard  ReviewGrade = 3 // 어렵게 기억함
+	ReviewGood  ReviewGrade = 4 // 기억함
+	ReviewEasy  ReviewGrade = 5 // 쉽게 기억함
+)
+
+// SM-2 난이도 계수
+const (
+	DefaultEaseFactor = 2.5
+	MinEaseFactor     = 1.3
+)
+
+var reviewGradeNames = map[string]ReviewGrade{
+	"again": ReviewAgain,
+	"hard":  ReviewHard,
+	"good":  ReviewGood,
+	"easy":  ReviewEasy,
+}
+
+// ParseReviewGrade 평가 이름(again, hard, good, easy)을 ReviewGrade로 변환
+func ParseReviewGrade(name string) (ReviewGrade, bool) {
+	grade, ok := reviewGradeNames[name]
+	return grade, ok
+}
+
+// SRSState 문장 하나의 복습 상태
+type SRSState struct {
+	EaseFactor   float64
+	IntervalDays int
+	Repetitions  int
+	Lapses       int
+}
+
+// NewSRSState 처음 암기한 문장의 복습 상태
+func NewSRSState() SRSState {
+	return SRSState{EaseFactor: DefaultEaseFactor}
+}
+
+// Next SM-2 알고리즘으로 평가 후의 복습 상태 계산
+// 기억하지 못하면(q < 3) 처음부터 다시(1일 뒤) 복습하고, 기억하면 1일 → 6일 → 간격 × 난이도 계수로 늘어납니다.
+func (s SRSState) Next(grade ReviewGrade) SRSState {
+	q := float64(grade)
+	next := s
+	if next.EaseFactor == 0 {
+		next.EaseFactor = DefaultEaseFactor
+	}
+
+	if grade < ReviewHard {
+		next.Repetitions = 0
+		next.IntervalDays = 1
+		next.Lapses++
+	} else {
+		switch next.Repetitions {
+		case 0:
+			next.IntervalDays = 1
+		case 1:
+			next.IntervalDays = 6
+		default:
+			next.IntervalDays = int(math.Round(float64(s.IntervalDays) * next.EaseFactor))
+		}
+		next.Repetitions++
+	}
+
+	next.EaseFactor += 0.1 - (5-q)*(0.08+(5-q)*0.02)
+	if next.EaseFactor < MinEaseFactor {
+		next.EaseFactor = MinEaseFactor
+	}
+	return next
+}
+
+// DueAt from(평가 시각)에서 간격만큼 지난 다음 복습 시각
+// 날짜 단위로 더하므로 from의 타임존에서 같은 시각(서머타임이 바뀌어도 같은 벽시계 시각)이 됩니다.
+func (s SRSState) DueAt(from time.Time) time.Time {
+	return from.AddDate(0, 0, s.IntervalDays)
+}
@@ -0,0 +1,85 @@
+package pkg
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestSRSStateNext(t *testing.T) {
+	tests := []struct {
+		name  string
+		state SRSState
+		grade ReviewGrade
+		want  SRSState
+	}{
+		// 기억하면 1일 → 6일 → 간격 × 난이도 계수
+		{"first review", NewSRSState(), ReviewGood, SRSState{EaseFactor: 2.5, IntervalDays: 1, Repetitions: 1}},
+		{"second review", SRSState{EaseFactor: 2.5, IntervalDays: 1, Repetitions: 1}, ReviewGood, SRSState{EaseFactor: 2.5, IntervalDays: 6, Repetitions: 2}},
+		{"third review", SRSState{EaseFactor: 2.5, IntervalDays: 6, Repetitions: 2}, ReviewGood, SRSState{EaseFactor: 2.5, IntervalDays: 15, Repetitions: 3}},
+		{"interval rounds", SRSState{EaseFactor: 2.5, IntervalDays: 15, Repetitions: 3}, ReviewGood, SRSState{EaseFactor: 2.5, IntervalDays: 38, Repetitions: 4}},
+		// 간격은 평가 전의 난이도 계수로 계산
+		{"easy raises ease", SRSState{EaseFactor: 2.5, IntervalDays: 6, Repetitions: 2}, ReviewEasy, SRSState{EaseFactor: 2.6, IntervalDays: 15, Repetitions: 3}},
+		{"hard lowers ease", SRSState{EaseFactor: 2.5, IntervalDays: 6, Repetitions: 2}, ReviewHard, SRSState{EaseFactor: 2.36, IntervalDays: 15, Repetitions: 3}},
+		{"unset ease uses default", SRSState{}, ReviewGood, SRSState{EaseFactor: 2.5, IntervalDays: 1, Repetitions: 1}},
+
+		// 기억하지 못하면 반복 횟수를 0으로 되돌리고 1일 뒤 다시
+		{"again resets repetitions", SRSState{EaseFactor: 2.5, IntervalDays: 15, Repetitions: 3}, ReviewAgain, SRSState{EaseFactor: 1.96, IntervalDays: 1, Repetitions: 0, Lapses: 1}},
+		{"again counts lapses", SRSState{EaseFactor: 2.0, IntervalDays: 6, Repetitions: 2, Lapses: 2}, ReviewAgain, SRSState{EaseFactor: 1.46, IntervalDays: 1, Repetitions: 0, Lapses: 3}},
+		{"good after lapse restarts at one day", SRSState{EaseFactor: 1.96, IntervalDays: 1, Repetitions: 0, Lapses: 1}, ReviewGood, SRSState{EaseFactor: 1.96, IntervalDays: 1, Repetitions: 1, Lapses: 1}},
+
+		// 난이도 계수는 1.3 아래로 내려가지 않음
+		{"ease floor on again", SRSState{EaseFactor: 1.4, IntervalDays: 6, Repetitions: 2}, ReviewAgain, SRSState{EaseFactor: MinEaseFactor, IntervalDays: 1, Repetitions: 0, Lapses: 1}},
+		{"ease floor on hard", SRSState{EaseFactor: MinEaseFactor, IntervalDays: 6, Repetitions: 2}, ReviewHard, SRSState{EaseFactor: MinEaseFactor, IntervalDays: 8, Repetitions: 3}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.state.Next(tt.grade)
+			if got.IntervalDays != tt.want.IntervalDays || got.Repetitions != tt.want.Repetitions || got.Lapses != tt.want.Lapses ||
+				math.Abs(got.EaseFactor-tt.want.EaseFactor) > 1e-9 {
+				t.Errorf("%+v.Next(%d) = %+v, want %+v", tt.state, tt.grade, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSRSStateDueAt(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("LoadLocation() error = %v", err)
+	}
+	kst := time.FixedZone("KST", 9*60*60)
+
+	tests := []struct {
+		name     string
+		interval int
+		from     time.Time
+		want     time.Time
+	}{
+		{"one day", 1, time.Date(2026, 3, 10, 9, 30, 0, 0, kst), time.Date(2026, 3, 11, 9, 30, 0, 0, kst)},
+		{"across month end", 6, time.Date(2026, 1, 28, 23, 0, 0, 0, kst), time.Date(2026, 2, 3, 23, 0, 0, 0, kst)},
+		{"across February", 30, time.Date(2026, 2, 15, 8, 0, 0, 0, time.UTC), time.Date(2026, 3, 17, 8, 0, 0, 0, time.UTC)},
+		// 서머타임이 시작되는 날을 지나도 같은 벽시계 시각 (실제로는 23시간 뒤)
+		{"across DST start", 1, time.Date(2026, 3, 7, 12, 0, 0, 0, newYork), time.Date(2026, 3, 8, 12, 0, 0, 0, newYork)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := SRSState{IntervalDays: tt.interval}.DueAt(tt.from)
+			if !got.Equal(tt.want) {
+				t.Errorf("DueAt(%s) with %d days = %s, want %s", tt.from, tt.interval, got, tt.want)
+			}
+		})
+	}
+
+	// Next로 늘어난 간격이 그대로 반영됨
+	from := time.Date(2026, 3, 10, 9, 30, 0, 0, kst)
+	state := NewSRSState()
+	var days []int
+	for i := 0; i < 3; i++ {
+		state = state.Next(ReviewGood)
+		days = append(days, int(state.DueAt(from).Sub(from)/(24*time.Hour)))
+	}
+	if days[0] != 1 || days[1] != 6 || days[2] != 15 {
+		t.Errorf("due days after good reviews = %v, want [1 6 15]", days)
+	}
+}
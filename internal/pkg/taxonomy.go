@@ -0,0 +1,152 @@
+package pkg
+
+// LocalizedName 언어별 이름
+type LocalizedName struct {
+	KO string `json:"ko"`
+	JA string `json:"ja"`
+	EN string `json:"en"`
+}
+
+// SubCategoryGroup 분야별 SubCategory 묶음 (애니 100번대, 게임 200번대 ...)
+type SubCategoryGroup struct {
+	Category      Category
+	Code          string
+	Name          LocalizedName
+	SubCategories []SubCategory
+}
+
+// AllCategories 모든 Category 목록
+var AllCategories = []Category{
+	CategoryAnime,     // 1
+	CategoryGame,      // 2
+	CategoryMusic,     // 3
+	CategoryVtuber,    // 4
+	CategoryLifestyle, // 5
+	CategorySituation, // 6
+}
+
+// categoryInfo Category별 코드와 이름
+var categoryInfo = map[Category]struct {
+	Code string
+	Name LocalizedName
+}{
+	CategoryAnime:     {Code: "anime", Name: LocalizedName{KO: "애니/만화", JA: "アニメ・漫画", EN: "Anime & Manga"}},
+	CategoryGame:      {Code: "game", Name: LocalizedName{KO: "게임", JA: "ゲーム", EN: "Games"}},
+	CategoryMusic:     {Code: "music", Name: LocalizedName{KO: "음악", JA: "音楽", EN: "Music"}},
+	CategoryVtuber:    {Code: "vtuber", Name: LocalizedName{KO: "버튜버", JA: "VTuber", EN: "VTubers"}},
+	CategoryLifestyle: {Code: "lifestyle", Name: LocalizedName{KO: "오타쿠 라이프스타일", JA: "オタクライフ", EN: "Otaku Lifestyle"}},
+	CategorySituation: {Code: "situation", Name: LocalizedName{KO: "실전 오타쿠 상황", JA: "実践シチュエーション", EN: "Real-Life Situations"}},
+}
+
+// SubCategoryGroups 관심사 선택 화면에 보여줄 분야와 SubCategory
+// 소속은 SubCategory.CategoryParent로 정해지며, 분야는 AllCategories 순서, SubCategory는 AllSubCategories 순서입니다.
+var SubCategoryGroups = buildSubCategoryGroups()
+
+func buildSubCategoryGroups() []SubCategoryGroup {
+	members := make(map[Category][]SubCategory, len(AllCategories))
+	for _, subCategory := range AllSubCategories {
+		parent := subCategory.CategoryParent()
+		members[parent] = append(members[parent], subCategory)
+	}
+
+	groups := make([]SubCategoryGroup, 0, len(AllCategories))
+	for _, category := range AllCategories {
+		if len(members[category]) == 0 {
+			continue
+		}
+		info := categoryInfo[category]
+		groups = append(groups, SubCategoryGroup{
+			Category:      category,
+			Code:          info.Code,
+			Name:          info.Name,
+			SubCategories: members[category],
+		})
+	}
+	return groups
+}
+
+// AllPurposes 모든 학습 목적 목록
+var AllPurposes = []Purpose{
+	PurposeWatchAnime,  // 1
+	PurposeTalkFriends, // 2
+	PurposeTravel,      // 3
+	PurposeVtuber,      // 4
+	PurposeGame,        // 5
+	PurposeGoodsEvent,  // 6
+	PurposeOther,       // 7
+}
+
+// 한국어 이름은 SubCategory.Name, Level.Name을 그대로 씁니다.
+var subCategoryNames = map[SubCategory]LocalizedName{
+	SubCategoryAnimeBattleFantasySF:  {JA: "バトル・ファンタジー/SF", EN: "Battle / Fantasy & Sci-Fi"},
+	SubCategoryAnimeSliceLoveEmo:     {JA: "日常・ラブコメ/エモ", EN: "Slice of Life / Rom-Com"},
+	SubCategoryAnimeStoryMystery:     {JA: "ストーリー/ミステリー", EN: "Story / Mystery"},
+	SubCategoryGameRpgGacha:          {JA: "RPG/ガチャ", EN: "RPG / Gacha"},
+	SubCategoryGameRhythm:            {JA: "リズムゲーム", EN: "Rhythm Games"},
+	SubCategoryGameActionVsShoo:      {JA: "アクション/対戦・シューター", EN: "Action / Fighting & Shooters"},
+	SubCategoryMusicJpop:             {JA: "J-POP", EN: "J-Pop"},
+	SubCategoryMusicIdol:             {JA: "アイドル", EN: "Idols"},
+	SubCategoryMusicAnime:            {JA: "アニソン", EN: "Anime Songs"},
+	SubCategoryVtuber:                {JA: "VTuber", EN: "VTubers"},
+	SubCategoryLifePilgrimageTravel:  {JA: "聖地巡礼/オタク旅行", EN: "Pilgrimage / Otaku Travel"},
+	SubCategoryLifeGoodsCollect:      {JA: "グッズ/コレクション", EN: "Merch / Collecting"},
+	SubCategoryLifeComiketDoujin:     {JA: "コミケ/同人", EN: "Comiket / Doujin"},
+	SubCategorySitShoppingOrder:      {JA: "ショッピング/注文", EN: "Shopping / Ordering"},
+	SubCategorySitOnsiteLiveGreeting: {JA: "現場/ライブ", EN: "Events / Live Shows"},
+	SubCategorySitOtakuTalk:          {JA: "オタクトーク", EN: "Otaku Talk"},
+	SubCategorySitCollabCafeGameCtr:  {JA: "コラボカフェ/ゲームセンター", EN: "Collab Cafés / Arcades"},
+}
+
+var levelNames = map[Level]LocalizedName{
+	LevelBeginner: {JA: "超入門 (Lv0)", EN: "Absolute Beginner (Lv0)"},
+	LevelN5:       {JA: "N5レベル (Lv1)", EN: "N5 Level (Lv1)"},
+	LevelN4:       {JA: "N4レベル (Lv2)", EN: "N4 Level (Lv2)"},
+	LevelN3:       {JA: "N3レベル (Lv3)", EN: "N3 Level (Lv3)"},
+}
+
+var purposeNames = map[Purpose]LocalizedName{
+	PurposeWatchAnime:  {KO: "자막 없이 애니·만화 즐기려고", JA: "字幕なしでアニメ・漫画を楽しみたい", EN: "Enjoy anime and manga without subtitles"},
+	PurposeTalkFriends: {KO: "일본 친구와 대화하고 싶어서", JA: "日本の友達と話したい", EN: "Talk with Japanese friends"},
+	PurposeTravel:      {KO: "일본 여행에서 말하고 싶어서", JA: "日本旅行で話したい", EN: "Speak while traveling in Japan"},
+	PurposeVtuber:      {KO: "버튜버 방송/콘텐츠 이해하고 싶어서", JA: "VTuberの配信やコンテンツを理解したい", EN: "Understand VTuber streams and content"},
+	PurposeGame:        {KO: "좋아하는 게임의 일본 서버/콘텐츠 즐기려고", JA: "好きなゲームの日本サーバーを楽しみたい", EN: "Play Japanese servers of favorite games"},
+	PurposeGoodsEvent:  {KO: "굿즈 구매·이벤트 참가 때문에", JA: "グッズ購入・イベント参加のため", EN: "Buy merch and attend events"},
+	PurposeOther:       {KO: "기타", JA: "その他", EN: "Other"},
+}
+
+// LocalizedName SubCategory의 언어별 이름
+func (s SubCategory) LocalizedName() LocalizedName {
+	name := subCategoryNames[s]
+	name.KO = s.Name()
+	return name
+}
+
+// LocalizedName Level의 언어별 이름
+func (l Level) LocalizedName() LocalizedName {
+	name := levelNames[l]
+	name.KO = l.Name()
+	return name
+}
+
+// LocalizedName Purpose의 언어별 이름
+func (p Purpose) LocalizedName() LocalizedName {
+	return purposeNames[p]
+}
+
+// IsValid 분류 체계에 있는 SubCategory인지 확인
+func (s SubCategory) IsValid() bool {
+	_, ok := subCategoryNames[s]
+	return ok
+}
+
+// IsValid 분류 체계에 있는 Level인지 확인
+func (l Level) IsValid() bool {
+	_, ok := levelNames[l]
+	return ok
+}
+
+// IsValid 분류 체계에 있는 Purpose인지 확인
+func (p Purpose) IsValid() bool {
+	_, ok := purposeNames[p]
+	return ok
+}
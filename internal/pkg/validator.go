@@ -12,8 +12,8 @@ func IsValidEmail(email string) bool {
 }
 
 func IsValidPassword(password string) bool {
-	// 최소 6자 이상
-	return len(password) >= 6
+	// 최소 6자 이상, bcrypt 제한(72바이트) 이하
+	return len(password) >= 6 && len(password) <= maxPasswordBytes
 }
 
 func SanitizeString(s string) string {
@@ -0,0 +1,204 @@
+package repository
+
+import (
+	"github.com/jptaku/server/internal/model"
+	"github.com/jptaku/server/internal/pkg"
+	"gorm.io/gorm"
+)
+
+type BookmarkRepository struct {
+	db *gorm.DB
+}
+
+func NewBookmarkRepository(db *gorm.DB) *BookmarkRepository {
+	return &BookmarkRepository{db: db}
+}
+
+// ========================================
+// 즐겨찾기
+// ========================================
+
+func (r *BookmarkRepository) FindBookmark(userID, sentenceID uint) (*model.SentenceBookmark, error) {
+	var bookmark model.SentenceBookmark
+	err := r.db.Where("user_id = ? AND sentence_id = ?", userID, sentenceID).First(&bookmark).Error
+	if err != nil {
+		return nil, err
+	}
+	return &bookmark, nil
+}
+
+func (r *BookmarkRepository) CreateBookmark(bookmark *model.SentenceBookmark) error {
+	return r.db.Create(bookmark).Error
+}
+
+func (r *BookmarkRepository) SaveBookmark(bookmark *model.SentenceBookmark) error {
+	return r.db.Save(bookmark).Error
+}
+
+// DeleteBookmark 즐겨찾기 삭제 (삭제된 행 수 반환)
+func (r *BookmarkRepository) DeleteBookmark(userID, sentenceID uint) (int64, error) {
+	result := r.db.Where("user_id = ? AND sentence_id = ?", userID, sentenceID).Delete(&model.SentenceBookmark{})
+	return result.RowsAffected, result.Error
+}
+
+// FindBookmarkedSentenceIDs sentenceIDs 중 즐겨찾기한 문장 ID (한 번의 쿼리)
+func (r *BookmarkRepository) FindBookmarkedSentenceIDs(userID uint, sentenceIDs []uint) ([]uint, error) {
+	var ids []uint
+	if len(sentenceIDs) == 0 {
+		return ids, nil
+	}
+	err := r.db.Model(&model.SentenceBookmark{}).
+		Where("user_id = ? AND sentence_id IN ?", userID, sentenceIDs).
+		Pluck("sentence_id", &ids).Error
+	return ids, err
+}
+
+// ListBookmarks 즐겨찾기 목록 (폴더를 지정하면 폴더 안 순서대로, 아니면 최근 추가한 순)
+func (r *BookmarkRepository) ListBookmarks(userID uint, filter *model.BookmarkFilter, page, perPage int) ([]model.SentenceBookmark, int64, error) {
+	var bookmarks []model.SentenceBookmark
+	var total int64
+
+	query := r.db.Model(&model.SentenceBookmark{}).Where("sentence_bookmarks.user_id = ?", userID)
+	if filter.FolderID != nil {
+		query = whereFolder(query, "sentence_bookmarks.folder_id", *filter.FolderID)
+	}
+	if filter.SubCategory != nil || filter.Level != nil {
+		query = query.Joins("JOIN sentences ON sentences.id = sentence_bookmarks.sentence_id")
+		if filter.SubCategory != nil {
+			query = query.Where("sentences.sub_category = ?", *filter.SubCategory)
+		}
+		if filter.Level != nil {
+			query = query.Where("sentences.level = ?", *filter.Level)
+		}
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	order := "sentence_bookmarks.created_at DESC, sentence_bookmarks.id DESC"
+	if filter.FolderID != nil {
+		order = "sentence_bookmarks.position, sentence_bookmarks.id"
+	}
+
+	offset := (page - 1) * perPage
+	err := query.Preload("Sentence").
+		Order(order).
+		Offset(offset).
+		Limit(perPage).
+		Find(&bookmarks).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return bookmarks, total, nil
+}
+
+// NextBookmarkPosition 폴더(0이면 폴더 없음)의 마지막 다음 순서
+func (r *BookmarkRepository) NextBookmarkPosition(userID, folderID uint) (int, error) {
+	var next int
+	query := r.db.Model(&model.SentenceBookmark{}).Where("user_id = ?", userID)
+	err := whereFolder(query, "folder_id", folderID).
+		Select("COALESCE(MAX(position), -1) + 1").
+		Scan(&next).Error
+	return next, err
+}
+
+// ReorderBookmarks 폴더(0이면 폴더 없음) 안의 즐겨찾기를 sentenceIDs 순서로 정렬
+// sentenceIDs가 그 폴더의 즐겨찾기와 정확히 일치하지 않으면 pkg.ErrBadRequest를 반환합니다.
+func (r *BookmarkRepository) ReorderBookmarks(userID, folderID uint, sentenceIDs []uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		var ids []uint
+		query := tx.Model(&model.SentenceBookmark{}).Where("user_id = ?", userID)
+		if err := whereFolder(query, "folder_id", folderID).Pluck("sentence_id", &ids).Error; err != nil {
+			return err
+		}
+		if len(ids) != len(sentenceIDs) {
+			return pkg.ErrBadRequest
+		}
+
+		inFolder := make(map[uint]bool, len(ids))
+		for _, id := range ids {
+			inFolder[id] = true
+		}
+		for position, sentenceID := range sentenceIDs {
+			if !inFolder[sentenceID] {
+				return pkg.ErrBadRequest
+			}
+			delete(inFolder, sentenceID)
+
+			if err := tx.Model(&model.SentenceBookmark{}).
+				Where("user_id = ? AND sentence_id = ?", userID, sentenceID).
+				Update("position", position).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// ========================================
+// 폴더
+// ========================================
+
+func (r *BookmarkRepository) FindFolder(userID, folderID uint) (*model.BookmarkFolder, error) {
+	var folder model.BookmarkFolder
+	err := r.db.Where("user_id = ? AND id = ?", userID, folderID).First(&folder).Error
+	if err != nil {
+		return nil, err
+	}
+	return &folder, nil
+}
+
+func (r *BookmarkRepository) ListFolders(userID uint) ([]model.BookmarkFolder, error) {
+	var folders []model.BookmarkFolder
+	err := r.db.Where("user_id = ?", userID).Order("position, id").Find(&folders).Error
+	return folders, err
+}
+
+func (r *BookmarkRepository) CountFolders(userID uint) (int64, error) {
+	var count int64
+	err := r.db.Model(&model.BookmarkFolder{}).Where("user_id = ?", userID).Count(&count).Error
+	return count, err
+}
+
+func (r *BookmarkRepository) CreateFolder(folder *model.BookmarkFolder) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&model.BookmarkFolder{}).
+			Where("user_id = ?", folder.UserID).
+			Select("COALESCE(MAX(position), -1) + 1").
+			Scan(&folder.Position).Error; err != nil {
+			return err
+		}
+		return tx.Create(folder).Error
+	})
+}
+
+func (r *BookmarkRepository) SaveFolder(folder *model.BookmarkFolder) error {
+	return r.db.Save(folder).Error
+}
+
+// DeleteFolder 폴더 삭제 (안의 즐겨찾기는 폴더 없음으로 이동, 삭제된 행 수 반환)
+func (r *BookmarkRepository) DeleteFolder(userID, folderID uint) (int64, error) {
+	var deleted int64
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&model.SentenceBookmark{}).
+			Where("user_id = ? AND folder_id = ?", userID, folderID).
+			Update("folder_id", nil).Error; err != nil {
+			return err
+		}
+
+		result := tx.Where("user_id = ? AND id = ?", userID, folderID).Delete(&model.BookmarkFolder{})
+		deleted = result.RowsAffected
+		return result.Error
+	})
+	return deleted, err
+}
+
+// whereFolder 폴더 조건 (0이면 폴더 없음)
+func whereFolder(query *gorm.DB, column string, folderID uint) *gorm.DB {
+	if folderID == 0 {
+		return query.Where(column + " IS NULL")
+	}
+	return query.Where(column+" = ?", folderID)
+}
@@ -1,6 +1,8 @@
 package repository
 
 import (
+	"time"
+
 	"github.com/jptaku/server/internal/model"
 	"gorm.io/gorm"
 )
@@ -77,3 +79,12 @@ func (r *ChatRepository) GetSessionMessages(sessionID uint) ([]model.ChatMessage
 	}
 	return messages, nil
 }
+
+// ListSessionsSince since 이후 시작한 대화 세션 (날짜별 집계용, 메시지 제외)
+func (r *ChatRepository) ListSessionsSince(userID uint, since time.Time) ([]model.ChatSession, error) {
+	var sessions []model.ChatSession
+	err := r.db.Where("user_id = ? AND started_at >= ?", userID, since).
+		Order("started_at").
+		Find(&sessions).Error
+	return sessions, err
+}
@@ -1,6 +1,8 @@
 package repository
 
 import (
+	"time"
+
 	"github.com/jptaku/server/internal/model"
 	"gorm.io/gorm"
 )
@@ -61,3 +63,14 @@ func (r *FeedbackRepository) GetUserFeedbacks(userID uint, page, perPage int) ([
 
 	return feedbacks, total, nil
 }
+
+// AverageScore [from, to)에 시작한 대화의 피드백 평균 점수 (피드백이 없으면 0)
+func (r *FeedbackRepository) AverageScore(userID uint, from, to time.Time) (float64, error) {
+	var avg float64
+	err := r.db.Model(&model.Feedback{}).
+		Joins("JOIN chat_sessions ON feedbacks.session_id = chat_sessions.id").
+		Where("chat_sessions.user_id = ? AND chat_sessions.started_at >= ? AND chat_sessions.started_at < ?", userID, from, to).
+		Select("COALESCE(AVG(feedbacks.total_score), 0)").
+		Scan(&avg).Error
+	return avg, err
+}
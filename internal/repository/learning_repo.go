@@ -1,6 +1,8 @@
 package repository
 
 import (
+	"time"
+
 	"github.com/jptaku/server/internal/model"
 	"gorm.io/gorm"
 )
@@ -37,6 +39,20 @@ func (r *LearningRepository) FindByUserAndSentence(userID, sentenceID uint) (*mo
 	return &progress, nil
 }
 
+// FindLatestByUserAndSentences 문장별 가장 최근 학습 진행 상황 (한 번의 쿼리)
+func (r *LearningRepository) FindLatestByUserAndSentences(userID uint, sentenceIDs []uint) ([]model.LearningProgress, error) {
+	var progresses []model.LearningProgress
+	if len(sentenceIDs) == 0 {
+		return progresses, nil
+	}
+	err := r.db.Raw(`
+		SELECT DISTINCT ON (sentence_id) * FROM learning_progress
+		WHERE user_id = ? AND sentence_id IN ?
+		ORDER BY sentence_id, created_at DESC
+	`, userID, sentenceIDs).Scan(&progresses).Error
+	return progresses, err
+}
+
 func (r *LearningRepository) FindByDailySet(dailySetID uint) ([]model.LearningProgress, error) {
 	var progresses []model.LearningProgress
 	err := r.db.Where("daily_set_id = ?", dailySetID).
@@ -90,3 +106,12 @@ func (r *LearningRepository) CountCompletedToday(userID uint, dailySetID uint) (
 		Count(&count).Error
 	return count, err
 }
+
+// ListCompletedAt since 이후 암기 완료한 시각 목록 (날짜별 집계용)
+func (r *LearningRepository) ListCompletedAt(userID uint, since time.Time) ([]time.Time, error) {
+	var times []time.Time
+	err := r.db.Model(&model.LearningProgress{}).
+		Where("user_id = ? AND memorized = ? AND completed_at >= ?", userID, true, since).
+		Pluck("completed_at", &times).Error
+	return times, err
+}
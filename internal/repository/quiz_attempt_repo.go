@@ -0,0 +1,200 @@
+package repository
+
+import (
+	"github.com/jptaku/server/internal/model"
+	"gorm.io/gorm"
+)
+
+type QuizAttemptRepository struct {
+	db *gorm.DB
+}
+
+func NewQuizAttemptRepository(db *gorm.DB) *QuizAttemptRepository {
+	return &QuizAttemptRepository{db: db}
+}
+
+// Create 퀴즈 시도 저장 (AttemptNo는 같은 사용자/문장의 이전 시도 수 + 1로 채움)
+// 같은 문장을 동시에 제출해도 번호가 겹치지 않도록 사용자/문장 단위 advisory lock을 잡습니다.
+func (r *QuizAttemptRepository) Create(attempt *model.QuizAttempt) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("SELECT pg_advisory_xact_lock(?, ?)", int32(attempt.UserID), int32(attempt.SentenceID)).Error; err != nil {
+			return err
+		}
+
+		var last int
+		if err := tx.Model(&model.QuizAttempt{}).
+			Where("user_id = ? AND sentence_id = ?", attempt.UserID, attempt.SentenceID).
+			Select("COALESCE(MAX(attempt_no), 0)").
+			Scan(&last).Error; err != nil {
+			return err
+		}
+
+		attempt.AttemptNo = last + 1
+		return tx.Create(attempt).Error
+	})
+}
+
+// ListByUser 사용자의 퀴즈 시도 기록 (최근 순, sentenceID가 0이 아니면 그 문장만)
+func (r *QuizAttemptRepository) ListByUser(userID, sentenceID uint, page, perPage int) ([]model.QuizAttempt, int64, error) {
+	var attempts []model.QuizAttempt
+	var total int64
+
+	query := r.db.Model(&model.QuizAttempt{}).Where("user_id = ?", userID)
+	if sentenceID != 0 {
+		query = query.Where("sentence_id = ?", sentenceID)
+	}
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	err := query.Preload("Sentence").
+		Order("attempted_at DESC, id DESC").
+		Offset((page - 1) * perPage).
+		Limit(perPage).
+		Find(&attempts).Error
+	return attempts, total, err
+}
+
+// quizPartRowsSQL 시도를 유형별 한 행으로 펼친 결과 (user_id, sentence_id, attempt_no, type, correct, answer)
+// 유형별 기록(parts) 도입 전 시도는 빈칸 채우기/문장 배열 컬럼에서 가져옵니다.
+const quizPartRowsSQL = `
+	SELECT a.user_id, a.sentence_id, a.attempt_no, p->>'type' AS type,
+		COALESCE((p->>'correct')::boolean, FALSE) AS correct, COALESCE(p->'answer'->>'text', '') AS answer
+	FROM quiz_attempts a CROSS JOIN LATERAL jsonb_array_elements(a.parts) AS p
+	WHERE jsonb_typeof(a.parts) = 'array'
+	UNION ALL
+	SELECT user_id, sentence_id, attempt_no, 'fill_blank', fill_blank_correct, fill_blank_answer
+	FROM quiz_attempts WHERE parts IS NULL OR jsonb_typeof(parts) <> 'array'
+	UNION ALL
+	SELECT user_id, sentence_id, attempt_no, 'ordering', ordering_correct, ''
+	FROM quiz_attempts WHERE parts IS NULL OR jsonb_typeof(parts) <> 'array'`
+
+// quizChoiceTypes 가장 많이 고른 오답 보기를 집계할 유형 (보기 고르기 유형)
+var quizChoiceTypes = []string{"fill_blank", "meaning", "listening"}
+
+// SentenceStats 문장별 퀴즈 난이도 통계 (첫 시도 정답률이 낮은 순)
+// 첫 시도 정답률은 사용자별로 모든 유형을 처음 제출한 시도(complete) 기준이고, 유형별 정답률은 그 유형을 처음 제출한 시도 기준입니다.
+// 첫 시도가 minFirstAttempts 미만인 문장은 표본이 적어 제외합니다. sentenceID가 0이 아니면 그 문장만 집계합니다.
+func (r *QuizAttemptRepository) SentenceStats(sentenceID uint, minFirstAttempts, page, perPage int) ([]model.QuizSentenceStats, int64, error) {
+	ranked := r.db.Model(&model.QuizAttempt{}).
+		Select("*, ROW_NUMBER() OVER (PARTITION BY user_id, sentence_id, complete ORDER BY attempt_no) AS complete_no")
+	if sentenceID != 0 {
+		ranked = ranked.Where("sentence_id = ?", sentenceID)
+	}
+
+	grouped := r.db.Table("(?) AS a", ranked).
+		Select(`sentence_id,
+			COUNT(*) AS attempts,
+			COUNT(DISTINCT user_id) AS users,
+			COUNT(*) FILTER (WHERE attempt_no = 1) AS first_attempts,
+			COALESCE(AVG(all_correct::int) FILTER (WHERE complete AND complete_no = 1), 0) AS first_try_correct_rate`).
+		Group("sentence_id").
+		Having("COUNT(*) FILTER (WHERE attempt_no = 1) >= ?", minFirstAttempts)
+
+	var total int64
+	if err := r.db.Table("(?) AS stats", grouped).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var stats []model.QuizSentenceStats
+	err := r.db.Table("(?) AS stats", grouped).
+		Order("first_try_correct_rate ASC, attempts DESC, sentence_id").
+		Offset((page - 1) * perPage).
+		Limit(perPage).
+		Scan(&stats).Error
+	if err != nil || len(stats) == 0 {
+		return stats, total, err
+	}
+
+	ids := make([]uint, len(stats))
+	byID := make(map[uint]int, len(stats))
+	for i, s := range stats {
+		ids[i] = s.SentenceID
+		byID[s.SentenceID] = i
+	}
+	if err := r.fillTypeRates(stats, ids, byID); err != nil {
+		return nil, 0, err
+	}
+	if err := r.fillCommonWrongOptions(stats, ids, byID); err != nil {
+		return nil, 0, err
+	}
+	return stats, total, nil
+}
+
+// fillTypeRates 문장별 유형별 첫 시도 정답률 채우기 (그 유형을 제출하지 않은 시도는 세지 않음)
+func (r *QuizAttemptRepository) fillTypeRates(stats []model.QuizSentenceStats, ids []uint, byID map[uint]int) error {
+	var rows []struct {
+		SentenceID uint
+		Type       string
+		Rate       float64
+	}
+	err := r.db.Raw(`
+		SELECT sentence_id, type, AVG(correct::int) AS rate
+		FROM (
+			SELECT sentence_id, type, correct,
+				ROW_NUMBER() OVER (PARTITION BY user_id, sentence_id, type ORDER BY attempt_no) AS n
+			FROM (`+quizPartRowsSQL+`) AS part_rows
+			WHERE sentence_id IN ?
+		) AS firsts
+		WHERE n = 1
+		GROUP BY sentence_id, type
+	`, ids).Scan(&rows).Error
+	if err != nil {
+		return err
+	}
+
+	for _, row := range rows {
+		i, ok := byID[row.SentenceID]
+		if !ok {
+			continue
+		}
+		if stats[i].FirstTryTypeRates == nil {
+			stats[i].FirstTryTypeRates = make(map[string]float64)
+		}
+		stats[i].FirstTryTypeRates[row.Type] = row.Rate
+		switch row.Type {
+		case "fill_blank":
+			stats[i].FirstTryFillBlankRate = row.Rate
+		case "ordering":
+			stats[i].FirstTryOrderingRate = row.Rate
+		}
+	}
+	return nil
+}
+
+// fillCommonWrongOptions 문장별, 보기 고르기 유형별로 가장 많이 고른 오답 채우기 (한 번의 쿼리)
+func (r *QuizAttemptRepository) fillCommonWrongOptions(stats []model.QuizSentenceStats, ids []uint, byID map[uint]int) error {
+	var rows []struct {
+		SentenceID uint
+		Type       string
+		Answer     string
+		Count      int64
+	}
+	err := r.db.Raw(`
+		SELECT DISTINCT ON (sentence_id, type) sentence_id, type, answer, COUNT(*) AS count
+		FROM (`+quizPartRowsSQL+`) AS part_rows
+		WHERE sentence_id IN ? AND type IN ? AND NOT correct AND answer <> ''
+		GROUP BY sentence_id, type, answer
+		ORDER BY sentence_id, type, count DESC, answer
+	`, ids, quizChoiceTypes).Scan(&rows).Error
+	if err != nil {
+		return err
+	}
+
+	for _, row := range rows {
+		i, ok := byID[row.SentenceID]
+		if !ok {
+			continue
+		}
+		stats[i].CommonWrongOptions = append(stats[i].CommonWrongOptions, model.QuizWrongOption{
+			Type:   row.Type,
+			Option: row.Answer,
+			Count:  row.Count,
+		})
+		if row.Type == "fill_blank" {
+			stats[i].CommonWrongOption = row.Answer
+			stats[i].CommonWrongOptionCount = row.Count
+		}
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/jptaku/server/internal/model"
+	"gorm.io/gorm"
+)
+
+type ReviewRepository struct {
+	db *gorm.DB
+}
+
+func NewReviewRepository(db *gorm.DB) *ReviewRepository {
+	return &ReviewRepository{db: db}
+}
+
+func (r *ReviewRepository) FindByUserAndSentence(userID, sentenceID uint) (*model.SentenceReview, error) {
+	var review model.SentenceReview
+	err := r.db.Where("user_id = ? AND sentence_id = ?", userID, sentenceID).First(&review).Error
+	if err != nil {
+		return nil, err
+	}
+	return &review, nil
+}
+
+func (r *ReviewRepository) Save(review *model.SentenceReview) error {
+	return r.db.Save(review).Error
+}
+
+// ListDue before 전에 복습할 차례가 된 문장 (오래 밀린 순)
+func (r *ReviewRepository) ListDue(userID uint, before time.Time, limit int) ([]model.SentenceReview, error) {
+	var reviews []model.SentenceReview
+	err := r.db.Where("user_id = ? AND due_at < ?", userID, before).
+		Preload("Sentence").
+		Order("due_at").
+		Limit(limit).
+		Find(&reviews).Error
+	return reviews, err
+}
+
+// CountDue before 전에 복습할 차례가 된 문장 수
+func (r *ReviewRepository) CountDue(userID uint, before time.Time) (int64, error) {
+	var count int64
+	err := r.db.Model(&model.SentenceReview{}).
+		Where("user_id = ? AND due_at < ?", userID, before).
+		Count(&count).Error
+	return count, err
+}
+
+// FindDueSentenceIDs before 전에 복습할 차례가 된 문장 ID (오늘의 문장에 섞기용)
+func (r *ReviewRepository) FindDueSentenceIDs(userID uint, before time.Time, limit int) ([]uint, error) {
+	var ids []uint
+	err := r.db.Model(&model.SentenceReview{}).
+		Where("user_id = ? AND due_at < ?", userID, before).
+		Order("due_at").
+		Limit(limit).
+		Pluck("sentence_id", &ids).Error
+	return ids, err
+}
@@ -0,0 +1,122 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jptaku/server/internal/cache"
+	"github.com/jptaku/server/internal/model"
+	"gorm.io/gorm"
+)
+
+// 캐시 키 구조
+//
+//	sentence:{id}         -> model.Sentence
+//	sentence:detail:{id}  -> model.SentenceDetail (sentence ID 기준)
+const (
+	sentenceCacheKeyPrefix       = "sentence:"
+	sentenceDetailCacheKeyPrefix = "sentence:detail:"
+)
+
+// CachedSentenceRepository 문장/상세 조회에 읽기 관통 캐시를 적용한 SentenceRepository
+// 문장과 상세 정보는 생성된 뒤 바뀌지 않으므로 무효화 없이 TTL로만 만료됩니다.
+// 캐시하지 않는 메서드는 SentenceRepository를 그대로 사용합니다.
+type CachedSentenceRepository struct {
+	*SentenceRepository
+	cache *cache.ReadThrough
+	ttl   time.Duration
+}
+
+// NewCachedSentenceRepository CachedSentenceRepository 생성자
+func NewCachedSentenceRepository(repo *SentenceRepository, readThrough *cache.ReadThrough, ttl time.Duration) *CachedSentenceRepository {
+	return &CachedSentenceRepository{
+		SentenceRepository: repo,
+		cache:              readThrough,
+		ttl:                ttl,
+	}
+}
+
+func (r *CachedSentenceRepository) FindByID(id uint) (*model.Sentence, error) {
+	sentences, err := r.FindByIDs([]uint{id})
+	if err != nil {
+		return nil, err
+	}
+	if len(sentences) == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return &sentences[0], nil
+}
+
+// FindByIDs ids 순서대로 문장 조회 (캐시에 없는 문장만 한 번에 DB 조회)
+func (r *CachedSentenceRepository) FindByIDs(ids []uint) ([]model.Sentence, error) {
+	found, err := cache.FetchMany(context.Background(), r.cache, ids, sentenceCacheKey, r.ttl,
+		func(missing []uint) (map[uint]model.Sentence, error) {
+			sentences, err := r.SentenceRepository.FindByIDs(missing)
+			if err != nil {
+				return nil, err
+			}
+			loaded := make(map[uint]model.Sentence, len(sentences))
+			for _, sentence := range sentences {
+				loaded[sentence.ID] = sentence
+			}
+			return loaded, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	sentences := make([]model.Sentence, 0, len(ids))
+	for _, id := range ids {
+		if sentence, ok := found[id]; ok {
+			sentences = append(sentences, sentence)
+		}
+	}
+	return sentences, nil
+}
+
+func (r *CachedSentenceRepository) GetDetail(sentenceID uint) (*model.SentenceDetail, error) {
+	details, err := r.GetDetails([]uint{sentenceID})
+	if err != nil {
+		return nil, err
+	}
+	if len(details) == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return &details[0], nil
+}
+
+// GetDetails 여러 문장의 상세 정보 (캐시에 없는 문장만 한 번에 DB 조회)
+func (r *CachedSentenceRepository) GetDetails(sentenceIDs []uint) ([]model.SentenceDetail, error) {
+	found, err := cache.FetchMany(context.Background(), r.cache, sentenceIDs, sentenceDetailCacheKey, r.ttl,
+		func(missing []uint) (map[uint]model.SentenceDetail, error) {
+			details, err := r.SentenceRepository.GetDetails(missing)
+			if err != nil {
+				return nil, err
+			}
+			loaded := make(map[uint]model.SentenceDetail, len(details))
+			for _, detail := range details {
+				loaded[detail.SentenceID] = detail
+			}
+			return loaded, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	details := make([]model.SentenceDetail, 0, len(found))
+	for _, id := range sentenceIDs {
+		if detail, ok := found[id]; ok {
+			details = append(details, detail)
+		}
+	}
+	return details, nil
+}
+
+func sentenceCacheKey(id uint) string {
+	return fmt.Sprintf("%s%d", sentenceCacheKeyPrefix, id)
+}
+
+func sentenceDetailCacheKey(sentenceID uint) string {
+	return fmt.Sprintf("%s%d", sentenceDetailCacheKeyPrefix, sentenceID)
+}
@@ -1,14 +1,21 @@
 package repository
 
 import (
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/jptaku/server/internal/model"
+	"github.com/jptaku/server/internal/pkg"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type SentenceRepository struct {
 	db *gorm.DB
+
+	trigramOnce sync.Once
+	trigram     bool // pg_trgm 확장 설치 여부 (검색 시 한 번 확인)
 }
 
 func NewSentenceRepository(db *gorm.DB) *SentenceRepository {
@@ -24,12 +31,28 @@ func (r *SentenceRepository) FindByID(id uint) (*model.Sentence, error) {
 	return &sentence, nil
 }
 
+// FindByIDs ids 순서대로 문장 조회 (없는 ID는 건너뜀)
 func (r *SentenceRepository) FindByIDs(ids []uint) ([]model.Sentence, error) {
-	var sentences []model.Sentence
-	err := r.db.Where("id IN ?", ids).Find(&sentences).Error
+	if len(ids) == 0 {
+		return []model.Sentence{}, nil
+	}
+
+	var found []model.Sentence
+	err := r.db.Where("id IN ?", ids).Find(&found).Error
 	if err != nil {
 		return nil, err
 	}
+
+	byID := make(map[uint]model.Sentence, len(found))
+	for _, sentence := range found {
+		byID[sentence.ID] = sentence
+	}
+	sentences := make([]model.Sentence, 0, len(ids))
+	for _, id := range ids {
+		if sentence, ok := byID[id]; ok {
+			sentences = append(sentences, sentence)
+		}
+	}
 	return sentences, nil
 }
 
@@ -51,26 +74,35 @@ func (r *SentenceRepository) FindBySubCategories(subCategories []int, limit int)
 	return sentences, nil
 }
 
-func (r *SentenceRepository) FindRandom(level int, subCategories []int, limit int, excludeIDs []uint) ([]model.Sentence, error) {
-	var sentences []model.Sentence
-	query := r.db.Model(&model.Sentence{})
-
-	if level > 0 {
-		query = query.Where("level <= ?", level)
-	}
-
-	if len(subCategories) > 0 {
-		query = query.Where("sub_category IN ?", subCategories)
-	}
+// MaxSentenceID 가장 큰 문장 ID (추천 후보의 시작 지점 범위)
+func (r *SentenceRepository) MaxSentenceID() (uint, error) {
+	var maxID uint
+	err := r.db.Model(&model.Sentence{}).Select("COALESCE(MAX(id), 0)").Scan(&maxID).Error
+	return maxID, err
+}
 
-	if len(excludeIDs) > 0 {
-		query = query.Where("id NOT IN ?", excludeIDs)
+// FindCandidates 추천 후보 문장 조회
+// startID부터 ID 순으로 limit개를 가져오고, 모자라면 처음부터 이어서 가져옵니다. (sub_category, id) 인덱스만 사용합니다.
+func (r *SentenceRepository) FindCandidates(maxLevel, subCategory int, excludeIDs []uint, startID uint, limit int) ([]model.Sentence, error) {
+	query := func() *gorm.DB {
+		q := r.db.Where("sub_category = ? AND level <= ?", subCategory, maxLevel)
+		if len(excludeIDs) > 0 {
+			q = q.Where("id NOT IN ?", excludeIDs)
+		}
+		return q.Order("id")
 	}
 
-	err := query.Order("RANDOM()").Limit(limit).Find(&sentences).Error
-	if err != nil {
+	var sentences []model.Sentence
+	if err := query().Where("id >= ?", startID).Limit(limit).Find(&sentences).Error; err != nil {
 		return nil, err
 	}
+	if len(sentences) < limit && startID > 1 {
+		var wrapped []model.Sentence
+		if err := query().Where("id < ?", startID).Limit(limit - len(sentences)).Find(&wrapped).Error; err != nil {
+			return nil, err
+		}
+		sentences = append(sentences, wrapped...)
+	}
 	return sentences, nil
 }
 
@@ -100,6 +132,16 @@ func (r *SentenceRepository) GetDetail(sentenceID uint) (*model.SentenceDetail,
 	return &detail, nil
 }
 
+// GetDetails 여러 문장의 상세 정보 (한 번의 쿼리)
+func (r *SentenceRepository) GetDetails(sentenceIDs []uint) ([]model.SentenceDetail, error) {
+	var details []model.SentenceDetail
+	if len(sentenceIDs) == 0 {
+		return details, nil
+	}
+	err := r.db.Where("sentence_id IN ?", sentenceIDs).Find(&details).Error
+	return details, err
+}
+
 func (r *SentenceRepository) Create(sentence *model.Sentence) error {
 	return r.db.Create(sentence).Error
 }
@@ -138,27 +180,51 @@ func (r *SentenceRepository) GetDailySet(userID uint, date time.Time) (*model.Da
 	return &dailySet, nil
 }
 
-func (r *SentenceRepository) CreateDailySet(dailySet *model.DailySentenceSet) error {
-	return r.db.Create(dailySet).Error
+// CreateDailySet 데일리 세트 저장
+// 같은 날짜의 세트가 이미 있으면 저장하지 않고 false를 반환합니다. (user_id, date) 유니크 인덱스로 동시 요청에도 하나만 남습니다.
+func (r *SentenceRepository) CreateDailySet(dailySet *model.DailySentenceSet) (bool, error) {
+	result := r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}, {Name: "date"}},
+		DoNothing: true,
+	}).Create(dailySet)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
 }
 
 func (r *SentenceRepository) GetUserLearnedSentenceIDs(userID uint) ([]uint, error) {
 	var ids []uint
-	err := r.db.Model(&model.DailySentenceSet{}).Select("UNNEST(sentence_ids)").Where("user_id = ?", userID).Find(&ids).Error
+	err := r.db.Raw(`
+		SELECT DISTINCT e.id::bigint
+		FROM daily_sentence_sets d, jsonb_array_elements_text(d.sentence_ids) AS e(id)
+		WHERE d.user_id = ?
+	`, userID).Scan(&ids).Error
 	if err != nil {
 		return nil, err
 	}
 	return ids, nil
 }
 
-// GetPastDailySets 오늘 제외한 과거 학습 세트 조회 (최신순)
-func (r *SentenceRepository) GetPastDailySets(userID uint, page, perPage int) ([]model.DailySentenceSet, int64, error) {
+// GetRecentSubCategories since 이후 세트에 나온 문장들의 SubCategory (나온 횟수만큼 중복)
+func (r *SentenceRepository) GetRecentSubCategories(userID uint, since time.Time) ([]int, error) {
+	var subCategories []int
+	err := r.db.Raw(`
+		SELECT s.sub_category
+		FROM daily_sentence_sets d
+		CROSS JOIN jsonb_array_elements_text(d.sentence_ids) AS e(id)
+		JOIN sentences s ON s.id = e.id::bigint
+		WHERE d.user_id = ? AND d.date >= ?
+	`, userID, since.Format(pkg.DateLayout)).Scan(&subCategories).Error
+	return subCategories, err
+}
+
+// GetPastDailySets before(사용자의 오늘) 이전 학습 세트 조회 (최신순)
+func (r *SentenceRepository) GetPastDailySets(userID uint, before time.Time, page, perPage int) ([]model.DailySentenceSet, int64, error) {
 	var dailySets []model.DailySentenceSet
 	var total int64
 
-	today := time.Now().Truncate(24 * time.Hour)
-
-	query := r.db.Model(&model.DailySentenceSet{}).Where("user_id = ? AND date < ?", userID, today.Format("2006-01-02"))
+	query := r.db.Model(&model.DailySentenceSet{}).Where("user_id = ? AND date < ?", userID, before.Format(pkg.DateLayout))
 
 	query.Count(&total)
 
@@ -170,3 +236,70 @@ func (r *SentenceRepository) GetPastDailySets(userID uint, page, perPage int) ([
 
 	return dailySets, total, nil
 }
+
+// Search 문장 검색 (search_text 기준)
+// 검색어를 그대로 포함한 문장을 먼저 보여주고, pg_trgm이 있으면 철자가 비슷한 문장(word similarity)도 뒤에 이어서 보여줍니다.
+func (r *SentenceRepository) Search(filter *model.SentenceSearchFilter, page, perPage int) ([]model.Sentence, int64, error) {
+	var sentences []model.Sentence
+	var total int64
+
+	pattern := "%" + escapeLike(filter.Query) + "%"
+	trigram := r.hasTrigram()
+
+	query := r.db.Model(&model.Sentence{})
+	if trigram {
+		query = query.Where("(sentences.search_text LIKE ? OR ? <% sentences.search_text)", pattern, filter.Query)
+	} else {
+		query = query.Where("sentences.search_text LIKE ?", pattern)
+	}
+	if filter.Level != nil {
+		query = query.Where("sentences.level = ?", *filter.Level)
+	}
+	if filter.SubCategory != nil {
+		query = query.Where("sentences.sub_category = ?", *filter.SubCategory)
+	}
+	if filter.AssignedUserID != 0 {
+		query = query.Where(`EXISTS (
+			SELECT 1 FROM daily_sentence_sets d
+			WHERE d.user_id = ? AND d.date <= ? AND d.sentence_ids @> jsonb_build_array(sentences.id)
+		)`, filter.AssignedUserID, filter.AssignedUntil.Format(pkg.DateLayout))
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	order := clause.Expr{SQL: "(sentences.search_text LIKE ?) DESC, sentences.id", Vars: []interface{}{pattern}}
+	if trigram {
+		order = clause.Expr{
+			SQL:  "(sentences.search_text LIKE ?) DESC, word_similarity(?, sentences.search_text) DESC, sentences.id",
+			Vars: []interface{}{pattern, filter.Query},
+		}
+	}
+
+	offset := (page - 1) * perPage
+	err := query.Order(clause.OrderBy{Expression: order}).
+		Offset(offset).
+		Limit(perPage).
+		Find(&sentences).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return sentences, total, nil
+}
+
+// hasTrigram pg_trgm 확장이 설치되어 있는지 (처음 한 번만 조회)
+func (r *SentenceRepository) hasTrigram() bool {
+	r.trigramOnce.Do(func() {
+		if err := r.db.Raw(`SELECT EXISTS (SELECT 1 FROM pg_extension WHERE extname = 'pg_trgm')`).Scan(&r.trigram).Error; err != nil {
+			r.trigram = false
+		}
+	})
+	return r.trigram
+}
+
+// escapeLike LIKE 패턴의 특수 문자 이스케이프
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
@@ -0,0 +1,82 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/jptaku/server/internal/model"
+	"github.com/jptaku/server/internal/pkg"
+	"gorm.io/gorm"
+)
+
+type SessionRepository struct {
+	db *gorm.DB
+}
+
+func NewSessionRepository(db *gorm.DB) *SessionRepository {
+	return &SessionRepository{db: db}
+}
+
+func (r *SessionRepository) Create(session *model.UserSession) error {
+	return r.db.Create(session).Error
+}
+
+// FindByFamilyID refresh token family로 세션 조회 (폐기된 세션 포함)
+func (r *SessionRepository) FindByFamilyID(familyID string) (*model.UserSession, error) {
+	var session model.UserSession
+	err := r.db.Where("family_id = ?", familyID).First(&session).Error
+	if err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
+
+// ListActive 사용자의 활성 세션 목록 (최근 사용 순)
+// refresh token이 만료되어 더 이상 갱신할 수 없는 세션은 제외합니다.
+func (r *SessionRepository) ListActive(userID uint) ([]model.UserSession, error) {
+	var sessions []model.UserSession
+	err := r.db.Where("user_id = ? AND revoked_at IS NULL AND last_seen_at > ?", userID, time.Now().Add(-pkg.RefreshTokenDuration)).
+		Order("last_seen_at DESC").
+		Find(&sessions).Error
+	return sessions, err
+}
+
+// Touch 토큰 갱신 시 마지막 사용 정보 갱신
+func (r *SessionRepository) Touch(id uint, userAgent, platform, ip string) error {
+	updates := map[string]interface{}{"last_seen_at": time.Now()}
+	if userAgent != "" {
+		updates["user_agent"] = userAgent
+		updates["platform"] = platform
+	}
+	if ip != "" {
+		updates["ip"] = ip
+	}
+	return r.db.Model(&model.UserSession{}).Where("id = ?", id).Updates(updates).Error
+}
+
+// Revoke 사용자의 세션 하나를 폐기하고 refresh token family 반환
+func (r *SessionRepository) Revoke(userID, id uint) (string, error) {
+	var session model.UserSession
+	err := r.db.Where("id = ? AND user_id = ? AND revoked_at IS NULL", id, userID).First(&session).Error
+	if err != nil {
+		return "", err
+	}
+
+	if err := r.db.Model(&session).Update("revoked_at", time.Now()).Error; err != nil {
+		return "", err
+	}
+	return session.FamilyID, nil
+}
+
+// RevokeByFamilyID refresh token family에 해당하는 세션 폐기 (로그아웃)
+func (r *SessionRepository) RevokeByFamilyID(familyID string) error {
+	return r.db.Model(&model.UserSession{}).
+		Where("family_id = ? AND revoked_at IS NULL", familyID).
+		Update("revoked_at", time.Now()).Error
+}
+
+// RevokeAll 사용자의 모든 세션 폐기
+func (r *SessionRepository) RevokeAll(userID uint) error {
+	return r.db.Model(&model.UserSession{}).
+		Where("user_id = ? AND revoked_at IS NULL", userID).
+		Update("revoked_at", time.Now()).Error
+}
@@ -1,8 +1,13 @@
 package repository
 
 import (
+	"strings"
+	"time"
+
 	"github.com/jptaku/server/internal/model"
+	"github.com/jptaku/server/internal/pkg"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type UserRepository struct {
@@ -48,6 +53,18 @@ func (r *UserRepository) Update(user *model.User) error {
 	return r.db.Save(user).Error
 }
 
+// UpdateRole 사용자 역할 변경
+func (r *UserRepository) UpdateRole(userID uint, role string) error {
+	return r.db.Model(&model.User{}).Where("id = ?", userID).Update("role", role).Error
+}
+
+// GetRole 사용자의 현재 역할 (사용자가 없으면 빈 문자열)
+func (r *UserRepository) GetRole(userID uint) (string, error) {
+	var role string
+	err := r.db.Model(&model.User{}).Where("id = ?", userID).Limit(1).Pluck("role", &role).Error
+	return role, err
+}
+
 func (r *UserRepository) Delete(id uint) error {
 	return r.db.Delete(&model.User{}, id).Error
 }
@@ -60,6 +77,36 @@ func (r *UserRepository) UpdateSettings(settings *model.UserSettings) error {
 	return r.db.Save(settings).Error
 }
 
+// GetTimezone 사용자 설정의 타임존
+func (r *UserRepository) GetTimezone(userID uint) (string, error) {
+	var timezone string
+	err := r.db.Model(&model.UserSettings{}).Where("user_id = ?", userID).Limit(1).Pluck("timezone", &timezone).Error
+	return timezone, err
+}
+
+// GetQuizSeed 사용자의 퀴즈 보기 순서 시드 (없으면 발급 후 저장)
+// 동시에 발급되면 먼저 저장된 시드를 사용합니다.
+func (r *UserRepository) GetQuizSeed(userID uint) (int64, error) {
+	var seed int64
+	err := r.db.Model(&model.User{}).Where("id = ?", userID).Limit(1).Pluck("quiz_seed", &seed).Error
+	if err != nil || seed != 0 {
+		return seed, err
+	}
+
+	newSeed, err := pkg.NewQuizSeed()
+	if err != nil {
+		return 0, err
+	}
+	if err := r.db.Model(&model.User{}).
+		Where("id = ? AND quiz_seed = 0", userID).
+		Update("quiz_seed", newSeed).Error; err != nil {
+		return 0, err
+	}
+
+	err = r.db.Model(&model.User{}).Where("id = ?", userID).Limit(1).Pluck("quiz_seed", &seed).Error
+	return seed, err
+}
+
 func (r *UserRepository) GetSettings(userID uint) (*model.UserSettings, error) {
 	var settings model.UserSettings
 	err := r.db.Where("user_id = ?", userID).First(&settings).Error
@@ -85,3 +132,371 @@ func (r *UserRepository) GetOnboarding(userID uint) (*model.UserOnboarding, erro
 	}
 	return &onboarding, nil
 }
+
+// ========================================
+// 로그인 수단 (user_identities)
+// ========================================
+
+// FindIdentity 공급자와 공급자 사용자 ID로 identity 조회
+func (r *UserRepository) FindIdentity(provider, providerID string) (*model.UserIdentity, error) {
+	var identity model.UserIdentity
+	err := r.db.Where("provider = ? AND provider_id = ?", provider, providerID).First(&identity).Error
+	if err != nil {
+		return nil, err
+	}
+	return &identity, nil
+}
+
+// ListIdentities 사용자에 연결된 identity 목록
+func (r *UserRepository) ListIdentities(userID uint) ([]model.UserIdentity, error) {
+	var identities []model.UserIdentity
+	err := r.db.Where("user_id = ?", userID).Order("created_at ASC").Find(&identities).Error
+	return identities, err
+}
+
+// HasVerifiedIdentityEmail 사용자의 identity 중 공급자가 확인한 해당 이메일이 있는지
+func (r *UserRepository) HasVerifiedIdentityEmail(userID uint, email string) (bool, error) {
+	var count int64
+	err := r.db.Model(&model.UserIdentity{}).
+		Where("user_id = ? AND LOWER(email) = LOWER(?) AND email_verified = ?", userID, email, true).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// CreateIdentity identity 생성
+func (r *UserRepository) CreateIdentity(identity *model.UserIdentity) error {
+	return r.db.Create(identity).Error
+}
+
+// UpdateIdentity identity 수정
+func (r *UserRepository) UpdateIdentity(identity *model.UserIdentity) error {
+	return r.db.Save(identity).Error
+}
+
+// DeleteIdentity 사용자의 특정 공급자 identity 삭제
+// 마지막 남은 로그인 수단은 삭제하지 않으며, 가입 공급자를 지운 경우 users.provider를 남은 identity로 옮깁니다.
+func (r *UserRepository) DeleteIdentity(userID uint, provider string) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		var identities []model.UserIdentity
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("user_id = ?", userID).Order("created_at ASC").Find(&identities).Error; err != nil {
+			return err
+		}
+
+		var target *model.UserIdentity
+		var remaining []model.UserIdentity
+		for i := range identities {
+			if identities[i].Provider == provider {
+				target = &identities[i]
+			} else {
+				remaining = append(remaining, identities[i])
+			}
+		}
+		if target == nil {
+			return gorm.ErrRecordNotFound
+		}
+		if len(remaining) == 0 {
+			return pkg.ErrLastIdentity
+		}
+
+		if err := tx.Delete(target).Error; err != nil {
+			return err
+		}
+
+		return tx.Model(&model.User{}).
+			Where("id = ? AND provider = ?", userID, provider).
+			Updates(map[string]interface{}{
+				"provider":    remaining[0].Provider,
+				"provider_id": remaining[0].ProviderID,
+			}).Error
+	})
+}
+
+// MergeUsers source 사용자의 identity와 학습 데이터를 target으로 옮기고 source를 삭제
+// 같은 문장/날짜 데이터가 양쪽에 있으면 target 쪽을 유지합니다.
+// 두 사용자에게 같은 공급자의 서로 다른 identity가 있으면 pkg.ErrMergeConflict를 반환합니다.
+func (r *UserRepository) MergeUsers(targetID, sourceID uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		var conflicts int64
+		if err := tx.Model(&model.UserIdentity{}).
+			Where("user_id = ? AND provider IN (?)", sourceID,
+				tx.Model(&model.UserIdentity{}).Select("provider").Where("user_id = ?", targetID)).
+			Count(&conflicts).Error; err != nil {
+			return err
+		}
+		if conflicts > 0 {
+			return pkg.ErrMergeConflict
+		}
+
+		steps := []string{
+			`UPDATE user_identities SET user_id = @target, updated_at = NOW() WHERE user_id = @source`,
+
+			`DELETE FROM learning_progress s USING learning_progress t
+			 WHERE s.user_id = @source AND t.user_id = @target AND s.sentence_id = t.sentence_id`,
+			`UPDATE learning_progress SET user_id = @target WHERE user_id = @source`,
+
+			// 같은 날짜의 세트가 겹치면 target 세트를 남기므로, source 세트를 가리키던 기록을 먼저 옮김
+			`UPDATE learning_progress p SET daily_set_id = t.id
+			 FROM daily_sentence_sets s, daily_sentence_sets t
+			 WHERE p.daily_set_id = s.id AND s.user_id = @source AND t.user_id = @target AND t.date = s.date`,
+			`UPDATE quiz_attempts a SET daily_set_id = t.id
+			 FROM daily_sentence_sets s, daily_sentence_sets t
+			 WHERE a.daily_set_id = s.id AND s.user_id = @source AND t.user_id = @target AND t.date = s.date`,
+			`UPDATE chat_sessions c SET daily_set_id = t.id
+			 FROM daily_sentence_sets s, daily_sentence_sets t
+			 WHERE c.daily_set_id = s.id AND s.user_id = @source AND t.user_id = @target AND t.date = s.date`,
+			`DELETE FROM daily_sentence_sets s USING daily_sentence_sets t
+			 WHERE s.user_id = @source AND t.user_id = @target AND s.date = t.date`,
+			`UPDATE daily_sentence_sets SET user_id = @target WHERE user_id = @source`,
+
+			`DELETE FROM sentence_reviews s USING sentence_reviews t
+			 WHERE s.user_id = @source AND t.user_id = @target AND s.sentence_id = t.sentence_id`,
+			`UPDATE sentence_reviews SET user_id = @target WHERE user_id = @source`,
+
+			// 퀴즈 기록은 모두 옮기고 문장별 시도 번호를 시각 순으로 다시 매김
+			`UPDATE quiz_attempts SET user_id = @target WHERE user_id = @source`,
+			`UPDATE quiz_attempts a SET attempt_no = n.rn
+			 FROM (SELECT id, ROW_NUMBER() OVER (PARTITION BY sentence_id ORDER BY attempted_at, id) AS rn
+			       FROM quiz_attempts WHERE user_id = @target) n
+			 WHERE a.id = n.id AND a.attempt_no <> n.rn`,
+
+			`UPDATE sentence_bookmarks b SET folder_id = t.id
+			 FROM bookmark_folders s, bookmark_folders t
+			 WHERE b.folder_id = s.id AND s.user_id = @source AND t.user_id = @target AND t.name = s.name`,
+			`DELETE FROM bookmark_folders s USING bookmark_folders t
+			 WHERE s.user_id = @source AND t.user_id = @target AND s.name = t.name`,
+			`UPDATE bookmark_folders SET user_id = @target WHERE user_id = @source`,
+			`DELETE FROM sentence_bookmarks s USING sentence_bookmarks t
+			 WHERE s.user_id = @source AND t.user_id = @target AND s.sentence_id = t.sentence_id`,
+			`UPDATE sentence_bookmarks SET user_id = @target WHERE user_id = @source`,
+
+			`UPDATE chat_sessions SET user_id = @target WHERE user_id = @source`,
+
+			`DELETE FROM user_settings WHERE user_id = @source
+			 AND EXISTS (SELECT 1 FROM user_settings WHERE user_id = @target)`,
+			`UPDATE user_settings SET user_id = @target WHERE user_id = @source`,
+
+			`DELETE FROM user_onboardings WHERE user_id = @source
+			 AND EXISTS (SELECT 1 FROM user_onboardings WHERE user_id = @target)`,
+			`UPDATE user_onboardings SET user_id = @target WHERE user_id = @source`,
+		}
+
+		args := map[string]interface{}{"target": targetID, "source": sourceID}
+		for _, sql := range steps {
+			if err := tx.Exec(sql, args).Error; err != nil {
+				return err
+			}
+		}
+
+		return tx.Delete(&model.User{}, sourceID).Error
+	})
+}
+
+// ========================================
+// 이메일/비밀번호 계정
+// ========================================
+
+// MarkEmailVerified 이메일 인증 완료 처리 (이메일 identity도 확인됨으로 표시)
+func (r *UserRepository) MarkEmailVerified(userID uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&model.User{}).
+			Where("id = ? AND email_verified_at IS NULL", userID).
+			Update("email_verified_at", time.Now()).Error; err != nil {
+			return err
+		}
+		return tx.Model(&model.UserIdentity{}).
+			Where("user_id = ? AND provider = ?", userID, pkg.LocalAuthProvider).
+			Update("email_verified", true).Error
+	})
+}
+
+// SetPassword 비밀번호 변경 (이메일 identity가 없으면 생성)
+// 재설정 메일의 토큰을 사용했다면 메일함 소유가 확인된 것이므로 이메일도 인증 처리합니다.
+func (r *UserRepository) SetPassword(user *model.User, passwordHash string) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&model.User{}).Where("id = ?", user.ID).Updates(map[string]interface{}{
+			"password_hash":     passwordHash,
+			"email_verified_at": gorm.Expr("COALESCE(email_verified_at, ?)", time.Now()),
+		}).Error; err != nil {
+			return err
+		}
+
+		identity := &model.UserIdentity{
+			UserID:        user.ID,
+			Provider:      pkg.LocalAuthProvider,
+			ProviderID:    strings.ToLower(user.Email),
+			Email:         user.Email,
+			EmailVerified: true,
+		}
+		return tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "user_id"}, {Name: "provider"}},
+			DoUpdates: clause.AssignmentColumns([]string{"email_verified", "updated_at"}),
+		}).Create(identity).Error
+	})
+}
+
+// ========================================
+// 게스트 계정
+// ========================================
+
+// ConvertGuest 게스트 사용자를 연결한 로그인 수단의 정식 사용자로 전환
+// 이메일은 다른 사용자가 쓰고 있지 않을 때만 바꾸고, 이름은 비어 있을 때만 채웁니다.
+func (r *UserRepository) ConvertGuest(userID uint, provider, providerID, email, name string) error {
+	return r.db.Exec(`
+		UPDATE users SET
+			provider = @provider,
+			provider_id = @provider_id,
+			name = CASE WHEN name = '' THEN @name ELSE name END,
+			email = CASE
+				WHEN @email <> '' AND NOT EXISTS (SELECT 1 FROM users u WHERE u.email = @email AND u.id <> users.id)
+				THEN @email ELSE email END,
+			updated_at = NOW()
+		WHERE id = @user AND provider = @guest
+	`, map[string]interface{}{
+		"user":        userID,
+		"provider":    provider,
+		"provider_id": providerID,
+		"email":       email,
+		"name":        name,
+		"guest":       pkg.GuestAuthProvider,
+	}).Error
+}
+
+// FindAbandonedGuests before 이후로 사용 기록이 없는 게스트 사용자 ID 목록
+func (r *UserRepository) FindAbandonedGuests(before time.Time, limit int) ([]uint, error) {
+	var ids []uint
+	err := r.db.Model(&model.User{}).
+		Where("provider = ? AND created_at < ?", pkg.GuestAuthProvider, before).
+		Where("NOT EXISTS (SELECT 1 FROM user_sessions s WHERE s.user_id = users.id AND s.last_seen_at >= ?)", before).
+		Order("id").
+		Limit(limit).
+		Pluck("id", &ids).Error
+	return ids, err
+}
+
+// ========================================
+// 계정 삭제 / 개인정보 내보내기
+// ========================================
+
+// FindActiveUserIDs since 이후 사용 기록(기기 세션)이 있는 사용자 ID (afterID 다음부터 ID 순, 탈퇴 요청자 제외)
+func (r *UserRepository) FindActiveUserIDs(since time.Time, afterID uint, limit int) ([]uint, error) {
+	var ids []uint
+	err := r.db.Model(&model.User{}).
+		Where("id > ? AND deletion_scheduled_at IS NULL", afterID).
+		Where("EXISTS (SELECT 1 FROM user_sessions s WHERE s.user_id = users.id AND s.revoked_at IS NULL AND s.last_seen_at >= ?)", since).
+		Order("id").
+		Limit(limit).
+		Pluck("id", &ids).Error
+	return ids, err
+}
+
+// ScheduleDeletion 탈퇴 요청 (at 이후 영구 삭제 대상)
+func (r *UserRepository) ScheduleDeletion(userID uint, at time.Time) error {
+	return r.db.Model(&model.User{}).Where("id = ?", userID).Update("deletion_scheduled_at", at).Error
+}
+
+// CancelDeletion 탈퇴 요청 취소
+func (r *UserRepository) CancelDeletion(userID uint) error {
+	return r.db.Model(&model.User{}).Where("id = ?", userID).Update("deletion_scheduled_at", nil).Error
+}
+
+// FindPurgeTargets 영구 삭제할 사용자 ID 목록
+// 유예 기간이 지난 탈퇴 사용자와, 병합되어 soft delete된 사용자가 대상입니다.
+func (r *UserRepository) FindPurgeTargets(now time.Time, limit int) ([]uint, error) {
+	var ids []uint
+	err := r.db.Unscoped().Model(&model.User{}).
+		Where("deletion_scheduled_at <= ? OR deleted_at IS NOT NULL", now).
+		Order("id").
+		Limit(limit).
+		Pluck("id", &ids).Error
+	return ids, err
+}
+
+// PurgeUser 사용자와 모든 관련 데이터를 영구 삭제
+func (r *UserRepository) PurgeUser(userID uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		steps := []string{
+			`DELETE FROM feedbacks WHERE session_id IN (SELECT id FROM chat_sessions WHERE user_id = @user)`,
+			`DELETE FROM chat_messages WHERE session_id IN (SELECT id FROM chat_sessions WHERE user_id = @user)`,
+			`DELETE FROM chat_sessions WHERE user_id = @user`,
+			`DELETE FROM learning_progress WHERE user_id = @user`,
+			`DELETE FROM sentence_reviews WHERE user_id = @user`,
+			`DELETE FROM quiz_attempts WHERE user_id = @user`,
+			`DELETE FROM sentence_bookmarks WHERE user_id = @user`,
+			`DELETE FROM bookmark_folders WHERE user_id = @user`,
+			`DELETE FROM daily_sentence_sets WHERE user_id = @user`,
+			`DELETE FROM user_sessions WHERE user_id = @user`,
+			`DELETE FROM user_identities WHERE user_id = @user`,
+			`DELETE FROM user_onboardings WHERE user_id = @user`,
+			`DELETE FROM user_settings WHERE user_id = @user`,
+		}
+
+		args := map[string]interface{}{"user": userID}
+		for _, sql := range steps {
+			if err := tx.Exec(sql, args).Error; err != nil {
+				return err
+			}
+		}
+
+		return tx.Unscoped().Delete(&model.User{}, userID).Error
+	})
+}
+
+func (r *UserRepository) ListSessions(userID uint) ([]model.UserSession, error) {
+	var sessions []model.UserSession
+	err := r.db.Where("user_id = ?", userID).Order("created_at").Find(&sessions).Error
+	return sessions, err
+}
+
+func (r *UserRepository) ListDailySets(userID uint) ([]model.DailySentenceSet, error) {
+	var sets []model.DailySentenceSet
+	err := r.db.Where("user_id = ?", userID).Order("date").Find(&sets).Error
+	return sets, err
+}
+
+func (r *UserRepository) ListLearningProgress(userID uint) ([]model.LearningProgress, error) {
+	var progress []model.LearningProgress
+	err := r.db.Where("user_id = ?", userID).Order("created_at").Find(&progress).Error
+	return progress, err
+}
+
+func (r *UserRepository) ListReviews(userID uint) ([]model.SentenceReview, error) {
+	var reviews []model.SentenceReview
+	err := r.db.Where("user_id = ?", userID).Order("created_at").Find(&reviews).Error
+	return reviews, err
+}
+
+func (r *UserRepository) ListQuizAttempts(userID uint) ([]model.QuizAttempt, error) {
+	var attempts []model.QuizAttempt
+	err := r.db.Where("user_id = ?", userID).Order("attempted_at, id").Find(&attempts).Error
+	return attempts, err
+}
+
+func (r *UserRepository) ListBookmarks(userID uint) ([]model.SentenceBookmark, error) {
+	var bookmarks []model.SentenceBookmark
+	err := r.db.Where("user_id = ?", userID).Order("id").Find(&bookmarks).Error
+	return bookmarks, err
+}
+
+func (r *UserRepository) ListBookmarkFolders(userID uint) ([]model.BookmarkFolder, error) {
+	var folders []model.BookmarkFolder
+	err := r.db.Where("user_id = ?", userID).Order("id").Find(&folders).Error
+	return folders, err
+}
+
+func (r *UserRepository) ListChatSessions(userID uint) ([]model.ChatSession, error) {
+	var sessions []model.ChatSession
+	err := r.db.Preload("Messages", func(db *gorm.DB) *gorm.DB {
+		return db.Order("created_at ASC")
+	}).Where("user_id = ?", userID).Order("created_at").Find(&sessions).Error
+	return sessions, err
+}
+
+func (r *UserRepository) ListFeedbacks(userID uint) ([]model.Feedback, error) {
+	var feedbacks []model.Feedback
+	err := r.db.Joins("JOIN chat_sessions ON chat_sessions.id = feedbacks.session_id").
+		Where("chat_sessions.user_id = ?", userID).
+		Order("feedbacks.created_at").
+		Find(&feedbacks).Error
+	return feedbacks, err
+}
@@ -0,0 +1,137 @@
+package repository
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/jptaku/server/internal/model"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// testDB TEST_DATABASE_URL의 Postgres에 스키마를 맞추고, 테스트가 끝나면 롤백되는 트랜잭션을 돌려줌
+// 병합 쿼리가 Postgres 문법(UPDATE ... FROM, DELETE ... USING)이라 다른 DB로는 대신할 수 없습니다.
+func testDB(t *testing.T) *gorm.DB {
+	t.Helper()
+
+	dsn := os.Getenv("TEST_DATABASE_URL")
+	if dsn == "" {
+		t.Skip("TEST_DATABASE_URL is not set")
+	}
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+	if err != nil {
+		t.Fatalf("open test database: %v", err)
+	}
+	if err := db.AutoMigrate(
+		&model.User{},
+		&model.UserIdentity{},
+		&model.UserSettings{},
+		&model.UserOnboarding{},
+		&model.Sentence{},
+		&model.DailySentenceSet{},
+		&model.LearningProgress{},
+		&model.SentenceReview{},
+		&model.QuizAttempt{},
+		&model.BookmarkFolder{},
+		&model.SentenceBookmark{},
+		&model.ChatSession{},
+	); err != nil {
+		t.Fatalf("migrate test database: %v", err)
+	}
+
+	tx := db.Begin()
+	t.Cleanup(func() { tx.Rollback() })
+	return tx
+}
+
+func mustCreate(t *testing.T, db *gorm.DB, value interface{}) {
+	t.Helper()
+	if err := db.Create(value).Error; err != nil {
+		t.Fatalf("create %T: %v", value, err)
+	}
+}
+
+func TestMergeUsersSameDateDailySet(t *testing.T) {
+	db := testDB(t)
+	repo := NewUserRepository(db)
+
+	suffix := time.Now().Format("150405.000000000")
+	target := &model.User{Email: "target-" + suffix + "@example.com", Provider: "google", ProviderID: "target-" + suffix}
+	source := &model.User{Email: "source-" + suffix + "@example.com", Provider: "kakao", ProviderID: "source-" + suffix}
+	mustCreate(t, db, target)
+	mustCreate(t, db, source)
+
+	sentences := []*model.Sentence{
+		{SentenceKey: "101_0", JP: "ごはんを食べる", KR: "밥을 먹다"},
+		{SentenceKey: "101_0", JP: "みずを飲む", KR: "물을 마시다"},
+	}
+	for _, s := range sentences {
+		mustCreate(t, db, s)
+	}
+
+	// 두 사용자 모두 같은 날 세트가 있고, 하루 전 세트는 source에만 있음
+	day := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	targetSet := &model.DailySentenceSet{UserID: target.ID, Date: day, SentenceIDs: []uint{sentences[0].ID}}
+	sourceSet := &model.DailySentenceSet{UserID: source.ID, Date: day, SentenceIDs: []uint{sentences[1].ID}}
+	sourceOnlySet := &model.DailySentenceSet{UserID: source.ID, Date: day.AddDate(0, 0, -1), SentenceIDs: []uint{sentences[1].ID}}
+	mustCreate(t, db, targetSet)
+	mustCreate(t, db, sourceSet)
+	mustCreate(t, db, sourceOnlySet)
+
+	progress := &model.LearningProgress{UserID: source.ID, SentenceID: sentences[1].ID, DailySetID: sourceSet.ID}
+	attempt := &model.QuizAttempt{UserID: source.ID, SentenceID: sentences[1].ID, DailySetID: sourceSet.ID, AttemptedAt: day}
+	session := &model.ChatSession{UserID: source.ID, DailySetID: sourceSet.ID, StartedAt: day}
+	earlierProgress := &model.LearningProgress{UserID: source.ID, SentenceID: sentences[0].ID, DailySetID: sourceOnlySet.ID}
+	mustCreate(t, db, progress)
+	mustCreate(t, db, attempt)
+	mustCreate(t, db, session)
+	mustCreate(t, db, earlierProgress)
+
+	if err := repo.MergeUsers(target.ID, source.ID); err != nil {
+		t.Fatalf("MergeUsers() error = %v", err)
+	}
+
+	var sets []model.DailySentenceSet
+	if err := db.Where("user_id IN ?", []uint{target.ID, source.ID}).Order("date").Find(&sets).Error; err != nil {
+		t.Fatalf("load daily sets: %v", err)
+	}
+	if len(sets) != 2 || sets[0].ID != sourceOnlySet.ID || sets[1].ID != targetSet.ID {
+		t.Fatalf("daily sets after merge = %+v, want the source-only set and the target set", sets)
+	}
+	for _, s := range sets {
+		if s.UserID != target.ID {
+			t.Errorf("daily set %d user_id = %d, want %d", s.ID, s.UserID, target.ID)
+		}
+	}
+
+	tests := []struct {
+		name  string
+		model interface{}
+		id    uint
+		want  uint
+	}{
+		{"learning progress on the colliding date", &model.LearningProgress{}, progress.ID, targetSet.ID},
+		{"quiz attempt on the colliding date", &model.QuizAttempt{}, attempt.ID, targetSet.ID},
+		{"chat session on the colliding date", &model.ChatSession{}, session.ID, targetSet.ID},
+		{"learning progress on a source-only date", &model.LearningProgress{}, earlierProgress.ID, sourceOnlySet.ID},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var row struct {
+				UserID     uint
+				DailySetID uint
+			}
+			if err := db.Model(tt.model).Select("user_id, daily_set_id").Where("id = ?", tt.id).Scan(&row).Error; err != nil {
+				t.Fatalf("load row: %v", err)
+			}
+			if row.UserID != target.ID {
+				t.Errorf("user_id = %d, want %d", row.UserID, target.ID)
+			}
+			if row.DailySetID != tt.want {
+				t.Errorf("daily_set_id = %d, want %d", row.DailySetID, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,26 @@
+package admin
+
+import (
+	"github.com/jptaku/server/internal/model"
+	"github.com/jptaku/server/internal/pkg"
+)
+
+// UserDetail 관리자용 사용자 조회 결과
+type UserDetail struct {
+	User        *model.User          `json:"user"`
+	Identities  []model.UserIdentity `json:"identities"`
+	Permissions []pkg.Permission     `json:"permissions"`
+}
+
+// UpdateRoleInput 역할 변경 입력
+type UpdateRoleInput struct {
+	Role pkg.Role
+}
+
+// QuizStatsInput 문장별 퀴즈 통계 조회 입력
+type QuizStatsInput struct {
+	SentenceID       uint // 0이면 전체 문장
+	MinFirstAttempts int  // 첫 시도가 이보다 적은 문장은 제외
+	Page             int
+	PerPage          int
+}
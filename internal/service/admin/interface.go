@@ -0,0 +1,38 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/jptaku/server/internal/model"
+)
+
+// UserRepository 사용자 저장소 인터페이스
+type UserRepository interface {
+	FindByID(id uint) (*model.User, error)
+	FindByEmail(email string) (*model.User, error)
+	ListIdentities(userID uint) ([]model.UserIdentity, error)
+	UpdateRole(userID uint, role string) error
+}
+
+// QuizAttemptRepository 퀴즈 시도 기록 저장소 인터페이스
+type QuizAttemptRepository interface {
+	SentenceStats(sentenceID uint, minFirstAttempts, page, perPage int) ([]model.QuizSentenceStats, int64, error)
+}
+
+// SessionRevoker 기기 세션 폐기 인터페이스 (auth.Service)
+type SessionRevoker interface {
+	RevokeAllSessions(ctx context.Context, userID uint) error
+}
+
+// RoleInvalidator 역할 캐시 무효화 인터페이스 (cache.RoleCache)
+type RoleInvalidator interface {
+	Invalidate(userID uint)
+}
+
+// Provider 서비스 인터페이스 (외부에서 사용)
+type Provider interface {
+	GetUser(userID uint) (*UserDetail, error)
+	FindUserByEmail(email string) (*UserDetail, error)
+	UpdateRole(ctx context.Context, actorID, userID uint, input *UpdateRoleInput) (*UserDetail, error)
+	GetQuizStats(input *QuizStatsInput) ([]model.QuizSentenceStats, int64, error)
+}
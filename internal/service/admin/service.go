@@ -0,0 +1,137 @@
+package admin
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/jptaku/server/internal/model"
+	"github.com/jptaku/server/internal/pkg"
+	"github.com/jptaku/server/internal/repository"
+)
+
+// Service 관리자 서비스
+type Service struct {
+	userRepo    UserRepository
+	attemptRepo QuizAttemptRepository
+	sessions    SessionRevoker  // nil이면 역할 변경 시 세션 폐기 생략
+	roles       RoleInvalidator // nil이면 캐시 무효화 생략
+}
+
+// 컴파일 타임 인터페이스 검증
+var _ Provider = (*Service)(nil)
+
+// NewService 서비스 생성자
+func NewService(userRepo UserRepository, attemptRepo QuizAttemptRepository) *Service {
+	return &Service{
+		userRepo:    userRepo,
+		attemptRepo: attemptRepo,
+	}
+}
+
+// SetSessionRevoker 역할 변경 시 대상 사용자의 세션을 폐기하도록 설정
+func (s *Service) SetSessionRevoker(sessions SessionRevoker) {
+	s.sessions = sessions
+}
+
+// SetRoleInvalidator 역할 변경 시 역할 캐시를 비우도록 설정
+func (s *Service) SetRoleInvalidator(roles RoleInvalidator) {
+	s.roles = roles
+}
+
+// GetUser 사용자 조회
+func (s *Service) GetUser(userID uint) (*UserDetail, error) {
+	user, err := s.userRepo.FindByID(userID)
+	if err != nil {
+		if repository.IsNotFound(err) {
+			return nil, pkg.ErrNotFound
+		}
+		return nil, err
+	}
+	return s.detail(user)
+}
+
+// FindUserByEmail 이메일로 사용자 조회
+func (s *Service) FindUserByEmail(email string) (*UserDetail, error) {
+	user, err := s.userRepo.FindByEmail(strings.ToLower(strings.TrimSpace(email)))
+	if err != nil {
+		if repository.IsNotFound(err) {
+			return nil, pkg.ErrNotFound
+		}
+		return nil, err
+	}
+	return s.detail(user)
+}
+
+// UpdateRole 사용자 역할 변경
+// 관리 라우트는 DB의 현재 역할로 권한을 확인하므로 강등은 바로 반영되고,
+// 대상 사용자의 모든 세션을 폐기해 이전 역할이 담긴 토큰으로는 갱신할 수 없게 합니다.
+// 관리자가 자기 자신의 역할을 바꿔 관리 권한을 잃는 일이 없도록 자기 변경은 막습니다.
+func (s *Service) UpdateRole(ctx context.Context, actorID, userID uint, input *UpdateRoleInput) (*UserDetail, error) {
+	if !input.Role.IsValid() {
+		return nil, pkg.ErrBadRequest
+	}
+	if actorID == userID {
+		return nil, pkg.ErrForbidden
+	}
+
+	user, err := s.userRepo.FindByID(userID)
+	if err != nil {
+		if repository.IsNotFound(err) {
+			return nil, pkg.ErrNotFound
+		}
+		return nil, err
+	}
+
+	if err := s.userRepo.UpdateRole(userID, string(input.Role)); err != nil {
+		return nil, err
+	}
+	log.Printf("Role changed by user %d: user=%d %s -> %s", actorID, userID, user.Role, input.Role)
+
+	if s.roles != nil {
+		s.roles.Invalidate(userID)
+	}
+	if s.sessions != nil {
+		if err := s.sessions.RevokeAllSessions(ctx, userID); err != nil {
+			log.Printf("Failed to revoke sessions after role change: user=%d err=%v", userID, err)
+		}
+	}
+
+	user.Role = string(input.Role)
+	return s.detail(user)
+}
+
+// GetQuizStats 문장별 퀴즈 난이도 통계 (첫 시도 정답률이 낮은 순)
+// 어려운 문장과 자주 고르는 오답 보기를 찾아 콘텐츠를 조정하는 데 씁니다.
+func (s *Service) GetQuizStats(input *QuizStatsInput) ([]model.QuizSentenceStats, int64, error) {
+	if input.Page < 1 {
+		input.Page = 1
+	}
+	if input.PerPage < 1 || input.PerPage > 100 {
+		input.PerPage = 20
+	}
+	if input.MinFirstAttempts < 1 {
+		input.MinFirstAttempts = 1
+	}
+
+	return s.attemptRepo.SentenceStats(input.SentenceID, input.MinFirstAttempts, input.Page, input.PerPage)
+}
+
+// detail 사용자 상세 (연결된 로그인 수단, 권한 포함)
+func (s *Service) detail(user *model.User) (*UserDetail, error) {
+	identities, err := s.userRepo.ListIdentities(user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	permissions := pkg.Role(user.Role).Permissions()
+	if permissions == nil {
+		permissions = []pkg.Permission{}
+	}
+
+	return &UserDetail{
+		User:        user,
+		Identities:  identities,
+		Permissions: permissions,
+	}, nil
+}
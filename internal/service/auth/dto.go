@@ -2,6 +2,50 @@ package auth
 
 import "github.com/jptaku/server/internal/model"
 
+// OAuthStartInput OAuth 로그인 시작 입력
+type OAuthStartInput struct {
+	ClientType          string // web / mobile
+	RedirectURI         string // 로그인 완료 후 이동할 URI (허용 목록에 있어야 함)
+	CodeChallenge       string // 클라이언트가 생성한 PKCE code_challenge (redirect 플로우 필수)
+	CodeChallengeMethod string // S256
+}
+
+// OAuthCallbackResult OAuth 콜백 처리 결과
+// 로그인: redirect 플로우는 LoginCode만, 그 외에는 Token만 채워집니다.
+// 계정 연결: 연결된 Identity, 또는 다른 계정의 identity였다면 MergeTicket이 채워집니다.
+type OAuthCallbackResult struct {
+	Token       *TokenResponse
+	LoginCode   string
+	Identity    *model.UserIdentity
+	MergeTicket string
+}
+
+// SignupInput 이메일 회원가입 입력
+type SignupInput struct {
+	Email    string
+	Password string
+	Name     string
+}
+
+// EmailLinks 메일에 넣을 링크 (token 쿼리가 추가됨)
+type EmailLinks struct {
+	VerifyURL string // 이메일 인증
+	ResetURL  string // 비밀번호 재설정 (앱/웹 화면)
+}
+
+// DeviceInfo 토큰을 발급받는 기기 정보 (기기 세션에 기록)
+type DeviceInfo struct {
+	UserAgent string
+	Platform  string // 클라이언트가 보낸 값 (ios, android, web), 비어 있으면 User-Agent로 추정
+	IP        string
+}
+
+// SessionInfo 기기 세션 조회 결과
+type SessionInfo struct {
+	model.UserSession
+	Current bool `json:"current"` // 요청한 access token의 세션인지
+}
+
 // TokenResponse 토큰 응답
 type TokenResponse struct {
 	AccessToken  string      `json:"access_token"`
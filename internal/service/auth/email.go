@@ -0,0 +1,257 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/jptaku/server/internal/model"
+	"github.com/jptaku/server/internal/pkg"
+	"github.com/jptaku/server/internal/repository"
+	"gorm.io/gorm"
+)
+
+// Signup 이메일/비밀번호 회원가입
+// 가입 직후 로그인 상태가 되며, 인증 메일은 백그라운드로 발송됩니다.
+func (s *Service) Signup(ctx context.Context, input *SignupInput, device *DeviceInfo) (*TokenResponse, error) {
+	email := normalizeEmail(input.Email)
+	if !pkg.IsValidEmail(email) || !pkg.IsValidPassword(input.Password) {
+		return nil, pkg.ErrBadRequest
+	}
+
+	if _, err := s.userRepo.FindByEmail(email); err == nil {
+		return nil, pkg.ErrDuplicateEmail
+	} else if !repository.IsNotFound(err) {
+		return nil, err
+	}
+
+	passwordHash, err := pkg.HashPassword(input.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	var user *model.User
+
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		user = &model.User{
+			Email:        email,
+			Name:         pkg.SanitizeString(input.Name),
+			Provider:     pkg.LocalAuthProvider,
+			ProviderID:   email,
+			PasswordHash: passwordHash,
+		}
+
+		if err := tx.Create(user).Error; err != nil {
+			return err
+		}
+
+		identity := &model.UserIdentity{
+			UserID:     user.ID,
+			Provider:   pkg.LocalAuthProvider,
+			ProviderID: email,
+			Email:      email,
+		}
+		if err := tx.Create(identity).Error; err != nil {
+			return err
+		}
+
+		settings := &model.UserSettings{
+			UserID: user.ID,
+		}
+		return tx.Create(settings).Error
+	})
+	if err != nil {
+		if repository.IsDuplicateError(err) {
+			return nil, pkg.ErrDuplicateEmail
+		}
+		return nil, err
+	}
+
+	if err := s.sendVerificationMail(ctx, user); err != nil {
+		log.Printf("Failed to issue verification mail for user %d: %v", user.ID, err)
+	}
+
+	return s.generateTokens(ctx, user, device)
+}
+
+// Login 이메일/비밀번호 로그인
+// 계정이 없거나 비밀번호가 없는(OAuth 전용) 계정도 같은 에러를 반환합니다.
+func (s *Service) Login(ctx context.Context, email, password string, device *DeviceInfo) (*TokenResponse, error) {
+	user, err := s.userRepo.FindByEmail(normalizeEmail(email))
+	if err != nil {
+		if !repository.IsNotFound(err) {
+			return nil, err
+		}
+		pkg.CheckPassword("", password)
+		return nil, pkg.ErrInvalidCredentials
+	}
+
+	if !pkg.CheckPassword(user.PasswordHash, password) {
+		return nil, pkg.ErrInvalidCredentials
+	}
+
+	user, err = s.userRepo.FindByID(user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.generateTokens(ctx, user, device)
+}
+
+// VerifyEmail 인증 메일의 토큰으로 이메일 인증
+func (s *Service) VerifyEmail(ctx context.Context, token string) error {
+	if s.emailTokens == nil {
+		return pkg.ErrInvalidEmailToken
+	}
+
+	userID, err := s.emailTokens.Consume(ctx, pkg.EmailTokenVerify, token)
+	if err != nil {
+		return err
+	}
+
+	return s.userRepo.MarkEmailVerified(userID)
+}
+
+// ResendVerification 인증 메일 재발송
+// 계정 존재 여부를 노출하지 않도록 대상이 없어도 성공으로 처리합니다.
+func (s *Service) ResendVerification(ctx context.Context, email string) error {
+	user, err := s.userRepo.FindByEmail(normalizeEmail(email))
+	if err != nil {
+		if repository.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if user.EmailVerifiedAt != nil || user.PasswordHash == "" {
+		return nil
+	}
+
+	return s.sendVerificationMail(ctx, user)
+}
+
+// RequestPasswordReset 비밀번호 재설정 메일 발송
+// 계정 존재 여부를 노출하지 않도록 대상이 없어도 성공으로 처리합니다.
+// OAuth 전용 계정도 메일함 소유를 확인한 뒤 비밀번호를 설정할 수 있습니다.
+func (s *Service) RequestPasswordReset(ctx context.Context, email string) error {
+	user, err := s.userRepo.FindByEmail(normalizeEmail(email))
+	if err != nil {
+		if repository.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if strings.HasSuffix(user.Email, "@"+pkg.PlaceholderEmailDomain) {
+		return nil
+	}
+
+	token, err := s.issueEmailToken(ctx, pkg.EmailTokenReset, user.ID, pkg.PasswordResetTokenTTL)
+	if err != nil {
+		return err
+	}
+
+	s.sendMail(&pkg.Mail{
+		To:      user.Email,
+		Subject: "[jptaku] 비밀번호 재설정",
+		Body: fmt.Sprintf("아래 링크에서 비밀번호를 재설정해 주세요. (1시간 동안 유효)\n\n%s\n\n요청하지 않았다면 이 메일은 무시하셔도 됩니다.",
+			withToken(s.emailLinks.ResetURL, token)),
+	})
+	return nil
+}
+
+// ResetPassword 재설정 토큰으로 비밀번호 변경
+// 변경 후에는 모든 기기 세션을 폐기합니다.
+func (s *Service) ResetPassword(ctx context.Context, token, password string) error {
+	if !pkg.IsValidPassword(password) {
+		return pkg.ErrBadRequest
+	}
+	if s.emailTokens == nil {
+		return pkg.ErrInvalidEmailToken
+	}
+
+	userID, err := s.emailTokens.Consume(ctx, pkg.EmailTokenReset, token)
+	if err != nil {
+		return err
+	}
+
+	user, err := s.userRepo.FindByID(userID)
+	if err != nil {
+		return pkg.ErrInvalidEmailToken
+	}
+
+	passwordHash, err := pkg.HashPassword(password)
+	if err != nil {
+		return err
+	}
+	if err := s.userRepo.SetPassword(user, passwordHash); err != nil {
+		return err
+	}
+
+	if err := s.RevokeAllSessions(ctx, user.ID); err != nil {
+		log.Printf("Failed to revoke sessions after password reset for user %d: %v", user.ID, err)
+	}
+	return nil
+}
+
+// sendVerificationMail 이메일 인증 토큰 발급 후 메일 발송
+func (s *Service) sendVerificationMail(ctx context.Context, user *model.User) error {
+	token, err := s.issueEmailToken(ctx, pkg.EmailTokenVerify, user.ID, pkg.EmailVerifyTokenTTL)
+	if err != nil {
+		return err
+	}
+
+	s.sendMail(&pkg.Mail{
+		To:      user.Email,
+		Subject: "[jptaku] 이메일 인증",
+		Body: fmt.Sprintf("jptaku 가입을 환영합니다!\n\n아래 링크를 눌러 이메일 인증을 완료해 주세요. (24시간 동안 유효)\n\n%s",
+			withToken(s.emailLinks.VerifyURL, token)),
+	})
+	return nil
+}
+
+// issueEmailToken 메일로 보낼 1회용 토큰 발급
+func (s *Service) issueEmailToken(ctx context.Context, purpose string, userID uint, ttl time.Duration) (string, error) {
+	if s.emailTokens == nil {
+		return "", pkg.ErrInvalidEmailToken
+	}
+
+	token, err := pkg.NewTokenID()
+	if err != nil {
+		return "", err
+	}
+	if err := s.emailTokens.Save(ctx, purpose, token, userID, ttl); err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+// sendMail AsyncService를 통해 백그라운드로 메일 발송 (재시도 포함)
+func (s *Service) sendMail(mail *pkg.Mail) {
+	if s.mailer == nil || s.emailSender == nil {
+		log.Printf("Mailer not configured, dropping mail to %s", mail.To)
+		return
+	}
+
+	s.emailSender.SubmitEmailSend(mail.To, func(ctx context.Context, _ string) error {
+		return s.mailer.Send(ctx, mail)
+	})
+}
+
+// withToken 링크에 token 쿼리 추가
+func withToken(link, token string) string {
+	u, err := url.Parse(link)
+	if err != nil {
+		return link
+	}
+	q := u.Query()
+	q.Set("token", token)
+	u.RawQuery = q.Encode()
+	return u.String()
+}
+
+// normalizeEmail 이메일 비교용 정규화 (공백 제거, 소문자)
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
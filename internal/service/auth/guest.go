@@ -0,0 +1,44 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jptaku/server/internal/model"
+	"github.com/jptaku/server/internal/pkg"
+	"gorm.io/gorm"
+)
+
+// CreateGuest 게스트 계정 생성 후 토큰 발급
+// 로그인 없이 온보딩과 학습을 시작할 수 있고, 나중에 공급자를 연결하면(GET /api/auth/link/{provider})
+// 같은 사용자가 정식 계정으로 전환됩니다.
+func (s *Service) CreateGuest(ctx context.Context, device *DeviceInfo) (*TokenResponse, error) {
+	guestID, err := pkg.NewTokenID()
+	if err != nil {
+		return nil, err
+	}
+
+	var user *model.User
+
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		user = &model.User{
+			Email:      fmt.Sprintf("%s_%s@%s", pkg.GuestAuthProvider, guestID, pkg.PlaceholderEmailDomain),
+			Provider:   pkg.GuestAuthProvider,
+			ProviderID: guestID,
+		}
+
+		if err := tx.Create(user).Error; err != nil {
+			return err
+		}
+
+		settings := &model.UserSettings{
+			UserID: user.ID,
+		}
+		return tx.Create(settings).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.generateTokens(ctx, user, device)
+}
@@ -0,0 +1,246 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/jptaku/server/internal/model"
+	"github.com/jptaku/server/internal/pkg"
+	"github.com/jptaku/server/internal/repository"
+	"gorm.io/gorm"
+)
+
+// findOrCreateOAuthUser 공급자 계정으로 로그인할 사용자 조회/생성
+//  1. 연결된 identity가 있으면 해당 사용자
+//  2. 같은 이메일의 사용자가 있고, 양쪽 공급자 모두 확인한 이메일이면 identity를 자동 연결
+//  3. 그 외에 같은 이메일이 있으면 ErrDuplicateEmail (기존 계정으로 로그인 후 연결해야 함)
+//  4. 없으면 새 사용자 생성
+func (s *Service) findOrCreateOAuthUser(userInfo *pkg.OAuthUserInfo) (*model.User, error) {
+	identity, err := s.userRepo.FindIdentity(userInfo.Provider, userInfo.ID)
+	if err == nil {
+		s.touchIdentity(identity, userInfo)
+		return s.userRepo.FindByID(identity.UserID)
+	}
+	if !repository.IsNotFound(err) {
+		return nil, err
+	}
+
+	if userInfo.Email != "" {
+		existing, err := s.userRepo.FindByEmail(userInfo.Email)
+		if err == nil {
+			return s.linkByVerifiedEmail(existing, userInfo)
+		}
+		if !repository.IsNotFound(err) {
+			return nil, err
+		}
+	}
+
+	return s.createOAuthUser(userInfo)
+}
+
+// linkByVerifiedEmail 같은 이메일의 기존 사용자에 identity 자동 연결
+// 이메일 소유가 양쪽 공급자 모두에서 확인된 경우에만 연결합니다.
+func (s *Service) linkByVerifiedEmail(user *model.User, userInfo *pkg.OAuthUserInfo) (*model.User, error) {
+	if !userInfo.EmailVerified {
+		return nil, pkg.ErrDuplicateEmail
+	}
+
+	verified, err := s.userRepo.HasVerifiedIdentityEmail(user.ID, userInfo.Email)
+	if err != nil {
+		return nil, err
+	}
+	if !verified {
+		return nil, pkg.ErrDuplicateEmail
+	}
+
+	if err := s.userRepo.CreateIdentity(newIdentity(user.ID, userInfo)); err != nil {
+		if repository.IsDuplicateError(err) {
+			return nil, pkg.ErrDuplicateEmail
+		}
+		return nil, err
+	}
+	log.Printf("Linked %s identity to user %d by verified email", userInfo.Provider, user.ID)
+
+	return s.userRepo.FindByID(user.ID)
+}
+
+// touchIdentity 로그인 시 identity의 이메일/최근 로그인 시각 갱신
+func (s *Service) touchIdentity(identity *model.UserIdentity, userInfo *pkg.OAuthUserInfo) {
+	now := time.Now()
+	identity.LastLoginAt = &now
+	if userInfo.Email != "" {
+		identity.Email = userInfo.Email
+		identity.EmailVerified = userInfo.EmailVerified
+	}
+	if err := s.userRepo.UpdateIdentity(identity); err != nil {
+		log.Printf("Failed to update identity %d: %v", identity.ID, err)
+	}
+}
+
+// createOAuthUser OAuth 사용자 생성 (identity, 기본 설정 포함)
+// 이메일을 주지 않는 공급자(Kakao 미동의, Apple 등)는 공급자 ID 기반 placeholder 이메일을 사용합니다.
+func (s *Service) createOAuthUser(userInfo *pkg.OAuthUserInfo) (*model.User, error) {
+	email := userInfo.Email
+	if email == "" {
+		email = placeholderEmail(userInfo.Provider, userInfo.ID)
+	}
+
+	var user *model.User
+
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		user = &model.User{
+			Email:      email,
+			Name:       userInfo.Name,
+			Provider:   userInfo.Provider,
+			ProviderID: userInfo.ID,
+		}
+
+		if err := tx.Create(user).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Create(newIdentity(user.ID, userInfo)).Error; err != nil {
+			return err
+		}
+
+		settings := &model.UserSettings{
+			UserID: user.ID,
+		}
+		return tx.Create(settings).Error
+	})
+
+	if err != nil {
+		if repository.IsDuplicateError(err) {
+			return nil, pkg.ErrDuplicateEmail
+		}
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// GetOAuthLinkURL 로그인한 사용자에 다른 공급자를 연결하기 위한 인증 URL 조회
+func (s *Service) GetOAuthLinkURL(ctx context.Context, userID uint, providerName string, input *OAuthStartInput) (string, error) {
+	return s.buildAuthURL(ctx, providerName, input, userID)
+}
+
+// linkIdentity 계정 연결 콜백 처리
+// 게스트 사용자가 연결하면 같은 사용자가 해당 공급자의 정식 사용자로 전환되어 학습 기록이 그대로 유지됩니다.
+// 공급자 계정이 이미 다른 사용자에 연결되어 있으면, 두 계정 모두 본인 소유임이 확인된 것이므로
+// 바로 합치지 않고 병합 티켓을 발급해 사용자의 명시적인 확인(POST /api/auth/merge)을 받습니다.
+func (s *Service) linkIdentity(ctx context.Context, userID uint, userInfo *pkg.OAuthUserInfo) (*OAuthCallbackResult, error) {
+	identity, err := s.userRepo.FindIdentity(userInfo.Provider, userInfo.ID)
+	if err == nil {
+		if identity.UserID == userID {
+			return &OAuthCallbackResult{Identity: identity}, nil
+		}
+		return s.issueMergeTicket(ctx, userID, identity.UserID)
+	}
+	if !repository.IsNotFound(err) {
+		return nil, err
+	}
+
+	identity = newIdentity(userID, userInfo)
+	if err := s.userRepo.CreateIdentity(identity); err != nil {
+		if repository.IsDuplicateError(err) {
+			return nil, pkg.ErrIdentityAlreadyLinked
+		}
+		return nil, err
+	}
+
+	if err := s.userRepo.ConvertGuest(userID, userInfo.Provider, userInfo.ID, userInfo.Email, userInfo.Name); err != nil {
+		return nil, err
+	}
+
+	return &OAuthCallbackResult{Identity: identity}, nil
+}
+
+// issueMergeTicket source 계정을 target 계정으로 합치기 위한 1회용 티켓 발급
+func (s *Service) issueMergeTicket(ctx context.Context, targetUserID, sourceUserID uint) (*OAuthCallbackResult, error) {
+	if s.mergeTickets == nil {
+		return nil, pkg.ErrIdentityAlreadyLinked
+	}
+
+	ticket, err := pkg.NewTokenID()
+	if err != nil {
+		return nil, err
+	}
+	if err := s.mergeTickets.Save(ctx, ticket, targetUserID, sourceUserID, pkg.MergeTicketTTL); err != nil {
+		return nil, err
+	}
+	return &OAuthCallbackResult{MergeTicket: ticket}, nil
+}
+
+// ListIdentities 연결된 로그인 수단 목록
+func (s *Service) ListIdentities(_ context.Context, userID uint) ([]model.UserIdentity, error) {
+	return s.userRepo.ListIdentities(userID)
+}
+
+// UnlinkIdentity 로그인 수단 연결 해제 (마지막 하나는 해제할 수 없음)
+func (s *Service) UnlinkIdentity(_ context.Context, userID uint, provider string) error {
+	err := s.userRepo.DeleteIdentity(userID, provider)
+	if repository.IsNotFound(err) {
+		return pkg.ErrNotFound
+	}
+	return err
+}
+
+// MergeAccounts 병합 티켓으로 다른 계정을 현재 계정에 합침
+// 합쳐진 계정은 삭제되고 해당 계정의 refresh token은 모두 폐기됩니다.
+func (s *Service) MergeAccounts(ctx context.Context, userID uint, ticket string) ([]model.UserIdentity, error) {
+	if s.mergeTickets == nil {
+		return nil, pkg.ErrInvalidMergeTicket
+	}
+
+	targetUserID, sourceUserID, err := s.mergeTickets.Consume(ctx, ticket)
+	if err != nil {
+		return nil, err
+	}
+	if targetUserID != userID {
+		return nil, pkg.ErrForbidden
+	}
+
+	if err := s.userRepo.MergeUsers(targetUserID, sourceUserID); err != nil {
+		return nil, err
+	}
+	log.Printf("Merged user %d into user %d", sourceUserID, targetUserID)
+
+	if err := s.RevokeAllSessions(ctx, sourceUserID); err != nil {
+		log.Printf("Failed to revoke sessions of merged user %d: %v", sourceUserID, err)
+	}
+
+	identities, err := s.userRepo.ListIdentities(targetUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 게스트 계정으로 병합한 경우 넘겨받은 로그인 수단의 정식 사용자로 전환
+	if len(identities) > 0 {
+		first := identities[0]
+		if err := s.userRepo.ConvertGuest(targetUserID, first.Provider, first.ProviderID, first.Email, ""); err != nil {
+			return nil, err
+		}
+	}
+
+	return identities, nil
+}
+
+// newIdentity 공급자 사용자 정보로 identity 생성
+func newIdentity(userID uint, userInfo *pkg.OAuthUserInfo) *model.UserIdentity {
+	now := time.Now()
+	return &model.UserIdentity{
+		UserID:        userID,
+		Provider:      userInfo.Provider,
+		ProviderID:    userInfo.ID,
+		Email:         userInfo.Email,
+		EmailVerified: userInfo.EmailVerified,
+		LastLoginAt:   &now,
+	}
+}
+
+// placeholderEmail 이메일이 없는 OAuth 계정용 고유 이메일
+func placeholderEmail(provider, providerID string) string {
+	return fmt.Sprintf("%s_%s@%s", provider, providerID, pkg.PlaceholderEmailDomain)
+}
@@ -2,6 +2,7 @@ package auth
 
 import (
 	"context"
+	"time"
 
 	"github.com/jptaku/server/internal/model"
 	"github.com/jptaku/server/internal/pkg"
@@ -11,7 +12,29 @@ import (
 // UserRepository 사용자 저장소 인터페이스
 type UserRepository interface {
 	FindByID(id uint) (*model.User, error)
-	FindByProviderID(provider, providerID string) (*model.User, error)
+	FindByEmail(email string) (*model.User, error)
+	FindIdentity(provider, providerID string) (*model.UserIdentity, error)
+	ListIdentities(userID uint) ([]model.UserIdentity, error)
+	HasVerifiedIdentityEmail(userID uint, email string) (bool, error)
+	CreateIdentity(identity *model.UserIdentity) error
+	UpdateIdentity(identity *model.UserIdentity) error
+	DeleteIdentity(userID uint, provider string) error
+	MergeUsers(targetID, sourceID uint) error
+	MarkEmailVerified(userID uint) error
+	SetPassword(user *model.User, passwordHash string) error
+	CancelDeletion(userID uint) error
+	ConvertGuest(userID uint, provider, providerID, email, name string) error
+}
+
+// SessionRepository 기기 세션 저장소 인터페이스
+type SessionRepository interface {
+	Create(session *model.UserSession) error
+	FindByFamilyID(familyID string) (*model.UserSession, error)
+	ListActive(userID uint) ([]model.UserSession, error)
+	Touch(id uint, userAgent, platform, ip string) error
+	Revoke(userID, id uint) (string, error)
+	RevokeByFamilyID(familyID string) error
+	RevokeAll(userID uint) error
 }
 
 // DBManager 데이터베이스 매니저 인터페이스
@@ -21,22 +44,73 @@ type DBManager interface {
 
 // JWTManager JWT 매니저 인터페이스
 type JWTManager interface {
-	GenerateToken(userID uint, email string) (string, error)
-	GenerateRefreshToken(userID uint, email string) (string, error)
-	ValidateToken(tokenString string) (*pkg.JWTClaims, error)
+	GenerateToken(userID uint, email string, role pkg.Role, familyID string) (string, error)
+	GenerateRefreshToken(userID uint, email, familyID string) (string, string, error)
+	ValidateAccessToken(tokenString string) (*pkg.JWTClaims, error)
+	ValidateRefreshToken(tokenString string) (*pkg.JWTClaims, error)
+}
+
+// RefreshTokenStore refresh token 저장소 인터페이스 (로테이션/폐기)
+type RefreshTokenStore interface {
+	Save(ctx context.Context, userID uint, tokenID, familyID string, ttl time.Duration) error
+	Consume(ctx context.Context, tokenID string) (string, error)
+	RevokeFamily(ctx context.Context, familyID string) error
+	RevokeUser(ctx context.Context, userID uint) error
+}
+
+// OAuthStateStore OAuth state 저장소 인터페이스 (nonce -> PKCE verifier, single-use)
+type OAuthStateStore interface {
+	Save(ctx context.Context, nonce, verifier string, ttl time.Duration) error
+	Consume(ctx context.Context, nonce string) (string, error)
+}
+
+// LoginCodeStore 1회용 로그인 코드 저장소 인터페이스
+type LoginCodeStore interface {
+	Save(ctx context.Context, code string, userID uint, challenge string, ttl time.Duration) error
+	Consume(ctx context.Context, code string) (uint, string, error)
+}
+
+// MergeTicketStore 계정 병합 티켓 저장소 인터페이스 (single-use)
+type MergeTicketStore interface {
+	Save(ctx context.Context, ticket string, targetUserID, sourceUserID uint, ttl time.Duration) error
+	Consume(ctx context.Context, ticket string) (uint, uint, error)
+}
+
+// EmailTokenStore 이메일 인증/비밀번호 재설정 토큰 저장소 인터페이스 (single-use)
+type EmailTokenStore interface {
+	Save(ctx context.Context, purpose, token string, userID uint, ttl time.Duration) error
+	Consume(ctx context.Context, purpose, token string) (uint, error)
 }
 
-// GoogleOAuthManager Google OAuth 매니저 인터페이스
-type GoogleOAuthManager interface {
-	GetAuthURL(state string) string
-	Exchange(ctx context.Context, code string) (interface{}, error)
-	GetUserInfo(ctx context.Context, token interface{}) (*pkg.GoogleUserInfo, error)
+// EmailSender 백그라운드 메일 발송 인터페이스 (service.AsyncService)
+type EmailSender interface {
+	SubmitEmailSend(email string, sendFn func(ctx context.Context, email string) error)
 }
 
 // Provider 서비스 인터페이스 (외부에서 사용)
 type Provider interface {
-	RefreshToken(refreshToken string) (*TokenResponse, error)
-	GetGoogleAuthURL(state string) string
-	GoogleCallback(ctx context.Context, code string) (*TokenResponse, error)
-	SetGoogleOAuth(googleOAuth *pkg.GoogleOAuthManager)
+	RefreshToken(ctx context.Context, refreshToken string, device *DeviceInfo) (*TokenResponse, error)
+	Logout(ctx context.Context, refreshToken string) error
+	GetOAuthAuthURL(ctx context.Context, provider string, input *OAuthStartInput) (string, error)
+	ParseOAuthState(state string) (*pkg.OAuthState, error)
+	OAuthCallback(ctx context.Context, provider, code string, state *pkg.OAuthState, device *DeviceInfo) (*OAuthCallbackResult, error)
+	ExchangeLoginCode(ctx context.Context, code, codeVerifier string, device *DeviceInfo) (*TokenResponse, error)
+	CreateGuest(ctx context.Context, device *DeviceInfo) (*TokenResponse, error)
+	Signup(ctx context.Context, input *SignupInput, device *DeviceInfo) (*TokenResponse, error)
+	Login(ctx context.Context, email, password string, device *DeviceInfo) (*TokenResponse, error)
+	VerifyEmail(ctx context.Context, token string) error
+	ResendVerification(ctx context.Context, email string) error
+	RequestPasswordReset(ctx context.Context, email string) error
+	ResetPassword(ctx context.Context, token, password string) error
+	GetOAuthLinkURL(ctx context.Context, userID uint, provider string, input *OAuthStartInput) (string, error)
+	ListIdentities(ctx context.Context, userID uint) ([]model.UserIdentity, error)
+	UnlinkIdentity(ctx context.Context, userID uint, provider string) error
+	ListSessions(ctx context.Context, userID uint, currentFamilyID string) ([]SessionInfo, error)
+	RevokeSession(ctx context.Context, userID, sessionID uint) error
+	RevokeAllSessions(ctx context.Context, userID uint) error
+	MergeAccounts(ctx context.Context, userID uint, ticket string) ([]model.UserIdentity, error)
+	SetOAuthProviders(providers *pkg.OAuthRegistry)
+	SetOAuthState(stateManager *pkg.OAuthStateManager, stateStore OAuthStateStore, loginCodes LoginCodeStore)
+	SetMergeTicketStore(mergeTickets MergeTicketStore)
+	SetEmailAuth(tokens EmailTokenStore, mailer pkg.Mailer, sender EmailSender, links EmailLinks)
 }
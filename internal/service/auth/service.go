@@ -2,65 +2,162 @@ package auth
 
 import (
 	"context"
+	"errors"
+	"log"
 
 	"github.com/jptaku/server/internal/model"
 	"github.com/jptaku/server/internal/pkg"
 	"github.com/jptaku/server/internal/repository"
-	"gorm.io/gorm"
+	"golang.org/x/oauth2"
 )
 
 // Service 인증 서비스
 type Service struct {
 	db          *repository.DBManager
 	userRepo    UserRepository
+	sessionRepo SessionRepository
 	jwtManager  *pkg.JWTManager
-	googleOAuth *pkg.GoogleOAuthManager
+	tokenStore  RefreshTokenStore
+	providers   *pkg.OAuthRegistry
+
+	stateManager *pkg.OAuthStateManager
+	stateStore   OAuthStateStore
+	loginCodes   LoginCodeStore
+	mergeTickets MergeTicketStore
+
+	emailTokens EmailTokenStore
+	mailer      pkg.Mailer
+	emailSender EmailSender
+	emailLinks  EmailLinks
 }
 
 // 컴파일 타임 인터페이스 검증
 var _ Provider = (*Service)(nil)
 
 // NewService 서비스 생성자
-func NewService(db *repository.DBManager, userRepo UserRepository, jwtManager *pkg.JWTManager) *Service {
+func NewService(db *repository.DBManager, userRepo UserRepository, sessionRepo SessionRepository, jwtManager *pkg.JWTManager, tokenStore RefreshTokenStore) *Service {
 	return &Service{
-		db:         db,
-		userRepo:   userRepo,
-		jwtManager: jwtManager,
+		db:          db,
+		userRepo:    userRepo,
+		sessionRepo: sessionRepo,
+		jwtManager:  jwtManager,
+		tokenStore:  tokenStore,
+		providers:   pkg.NewOAuthRegistry(),
 	}
 }
 
-// SetGoogleOAuth Google OAuth 설정
-func (s *Service) SetGoogleOAuth(googleOAuth *pkg.GoogleOAuthManager) {
-	s.googleOAuth = googleOAuth
+// SetOAuthProviders OAuth 공급자 레지스트리 설정
+func (s *Service) SetOAuthProviders(providers *pkg.OAuthRegistry) {
+	s.providers = providers
+}
+
+// SetOAuthState OAuth state 발급/저장소 및 로그인 코드 저장소 설정
+func (s *Service) SetOAuthState(stateManager *pkg.OAuthStateManager, stateStore OAuthStateStore, loginCodes LoginCodeStore) {
+	s.stateManager = stateManager
+	s.stateStore = stateStore
+	s.loginCodes = loginCodes
+}
+
+// SetMergeTicketStore 계정 병합 티켓 저장소 설정
+func (s *Service) SetMergeTicketStore(mergeTickets MergeTicketStore) {
+	s.mergeTickets = mergeTickets
+}
+
+// SetEmailAuth 이메일 계정용 토큰 저장소/메일러 설정
+func (s *Service) SetEmailAuth(tokens EmailTokenStore, mailer pkg.Mailer, sender EmailSender, links EmailLinks) {
+	s.emailTokens = tokens
+	s.mailer = mailer
+	s.emailSender = sender
+	s.emailLinks = links
 }
 
 // RefreshToken 토큰 갱신
-func (s *Service) RefreshToken(refreshToken string) (*TokenResponse, error) {
-	claims, err := s.jwtManager.ValidateToken(refreshToken)
-	if err != nil {
+// 사용된 refresh token은 즉시 폐기되고 같은 family의 새 토큰이 발급됩니다.
+// 이미 사용된 토큰이 다시 들어오면 탈취로 간주하고 family 전체를 폐기합니다.
+// 폐기된 기기 세션의 토큰은 갱신할 수 없습니다.
+func (s *Service) RefreshToken(ctx context.Context, refreshToken string, device *DeviceInfo) (*TokenResponse, error) {
+	claims, err := s.jwtManager.ValidateRefreshToken(refreshToken)
+	if err != nil || claims.ID == "" || claims.FamilyID == "" {
 		return nil, pkg.ErrInvalidToken
 	}
 
+	familyID, err := s.tokenStore.Consume(ctx, claims.ID)
+	if err != nil {
+		if errors.Is(err, pkg.ErrTokenReused) {
+			log.Printf("Refresh token reuse detected: user=%d family=%s", claims.UserID, familyID)
+			if revokeErr := s.tokenStore.RevokeFamily(ctx, familyID); revokeErr != nil {
+				log.Printf("Failed to revoke token family %s: %v", familyID, revokeErr)
+			}
+			return nil, pkg.ErrTokenReused
+		}
+		if errors.Is(err, pkg.ErrTokenRevoked) {
+			return nil, pkg.ErrTokenRevoked
+		}
+		return nil, err
+	}
+
+	if err := s.touchSession(ctx, claims.UserID, familyID, device); err != nil {
+		return nil, err
+	}
+
 	user, err := s.userRepo.FindByID(claims.UserID)
 	if err != nil {
 		return nil, pkg.ErrNotFound
 	}
 
-	return s.generateTokens(user)
+	return s.issueTokens(ctx, user, familyID)
+}
+
+// Logout refresh token이 속한 family 폐기
+func (s *Service) Logout(ctx context.Context, refreshToken string) error {
+	claims, err := s.jwtManager.ValidateRefreshToken(refreshToken)
+	if err != nil || claims.FamilyID == "" {
+		return pkg.ErrInvalidToken
+	}
+
+	if err := s.sessionRepo.RevokeByFamilyID(claims.FamilyID); err != nil {
+		log.Printf("Failed to revoke session of family %s: %v", claims.FamilyID, err)
+	}
+	return s.tokenStore.RevokeFamily(ctx, claims.FamilyID)
+}
+
+// generateTokens 새 로그인에 대한 토큰 생성 (새 family와 기기 세션 시작)
+// 탈퇴 유예 기간 중인 사용자가 다시 로그인하면 탈퇴가 취소됩니다.
+func (s *Service) generateTokens(ctx context.Context, user *model.User, device *DeviceInfo) (*TokenResponse, error) {
+	if user.DeletionScheduledAt != nil {
+		if err := s.userRepo.CancelDeletion(user.ID); err != nil {
+			return nil, err
+		}
+		log.Printf("Account deletion cancelled by login: user=%d", user.ID)
+		user.DeletionScheduledAt = nil
+	}
+
+	familyID, err := pkg.NewTokenID()
+	if err != nil {
+		return nil, err
+	}
+	if err := s.createSession(user.ID, familyID, device); err != nil {
+		return nil, err
+	}
+	return s.issueTokens(ctx, user, familyID)
 }
 
-// generateTokens 토큰 생성
-func (s *Service) generateTokens(user *model.User) (*TokenResponse, error) {
-	accessToken, err := s.jwtManager.GenerateToken(user.ID, user.Email)
+// issueTokens access/refresh token 발급 후 refresh token 저장
+func (s *Service) issueTokens(ctx context.Context, user *model.User, familyID string) (*TokenResponse, error) {
+	accessToken, err := s.jwtManager.GenerateToken(user.ID, user.Email, pkg.Role(user.Role), familyID)
 	if err != nil {
 		return nil, err
 	}
 
-	refreshToken, err := s.jwtManager.GenerateRefreshToken(user.ID, user.Email)
+	refreshToken, tokenID, err := s.jwtManager.GenerateRefreshToken(user.ID, user.Email, familyID)
 	if err != nil {
 		return nil, err
 	}
 
+	if err := s.tokenStore.Save(ctx, user.ID, tokenID, familyID, pkg.RefreshTokenDuration); err != nil {
+		return nil, err
+	}
+
 	return &TokenResponse{
 		AccessToken:  accessToken,
 		RefreshToken: refreshToken,
@@ -68,66 +165,130 @@ func (s *Service) generateTokens(user *model.User) (*TokenResponse, error) {
 	}, nil
 }
 
-// GetGoogleAuthURL Google 로그인 URL 조회
-func (s *Service) GetGoogleAuthURL(state string) string {
-	if s.googleOAuth == nil {
-		return ""
+// GetOAuthAuthURL 공급자 로그인 URL 조회
+// 서명된 state와 PKCE verifier를 새로 만들고, verifier는 서버에만 저장합니다.
+func (s *Service) GetOAuthAuthURL(ctx context.Context, providerName string, input *OAuthStartInput) (string, error) {
+	return s.buildAuthURL(ctx, providerName, input, 0)
+}
+
+// buildAuthURL state를 발급하고 공급자 인증 URL 생성 (linkUserID가 있으면 계정 연결용 state)
+func (s *Service) buildAuthURL(ctx context.Context, providerName string, input *OAuthStartInput, linkUserID uint) (string, error) {
+	provider, err := s.providers.Get(providerName)
+	if err != nil {
+		return "", err
+	}
+	if s.stateManager == nil {
+		return "", pkg.ErrInvalidOAuthState
+	}
+
+	state, err := s.stateManager.New(providerName, input.ClientType, input.RedirectURI, input.CodeChallenge, input.CodeChallengeMethod)
+	if err != nil {
+		return "", err
+	}
+	state.LinkUserID = linkUserID
+
+	encoded, err := s.stateManager.Encode(state)
+	if err != nil {
+		return "", err
+	}
+
+	verifier := oauth2.GenerateVerifier()
+	if err := s.stateStore.Save(ctx, state.Nonce, verifier, pkg.OAuthStateTTL); err != nil {
+		return "", err
+	}
+
+	return provider.AuthCodeURL(encoded, verifier), nil
+}
+
+// ParseOAuthState 콜백으로 돌아온 state의 서명 검증
+func (s *Service) ParseOAuthState(state string) (*pkg.OAuthState, error) {
+	if s.stateManager == nil {
+		return nil, pkg.ErrInvalidOAuthState
 	}
-	return s.googleOAuth.GetAuthURL(state)
+	return s.stateManager.Decode(state)
 }
 
-// GoogleCallback Google 로그인 콜백 처리
-func (s *Service) GoogleCallback(ctx context.Context, code string) (*TokenResponse, error) {
-	if s.googleOAuth == nil {
-		return nil, pkg.ErrInvalidCredentials
+// OAuthCallback 공급자 로그인/계정 연결 콜백 처리
+// state는 발급한 공급자에서 한 번만 사용할 수 있으며, 저장된 PKCE verifier로만 코드를 교환할 수 있습니다.
+func (s *Service) OAuthCallback(ctx context.Context, providerName, code string, state *pkg.OAuthState, device *DeviceInfo) (*OAuthCallbackResult, error) {
+	provider, err := s.providers.Get(providerName)
+	if err != nil {
+		return nil, err
+	}
+	if s.stateStore == nil || state.Provider != providerName {
+		return nil, pkg.ErrInvalidOAuthState
+	}
+
+	verifier, err := s.stateStore.Consume(ctx, state.Nonce)
+	if err != nil {
+		return nil, err
 	}
 
-	token, err := s.googleOAuth.Exchange(ctx, code)
+	token, err := provider.Exchange(ctx, code, verifier)
 	if err != nil {
+		log.Printf("OAuth code exchange failed: provider=%s err=%v", providerName, err)
 		return nil, pkg.ErrInvalidCredentials
 	}
 
-	userInfo, err := s.googleOAuth.GetUserInfo(ctx, token)
+	userInfo, err := provider.UserInfo(ctx, token)
 	if err != nil {
+		log.Printf("OAuth user info failed: provider=%s err=%v", providerName, err)
 		return nil, pkg.ErrInvalidCredentials
 	}
 
-	user, err := s.userRepo.FindByProviderID("google", userInfo.ID)
+	if state.LinkUserID != 0 {
+		return s.linkIdentity(ctx, state.LinkUserID, userInfo)
+	}
+
+	user, err := s.findOrCreateOAuthUser(userInfo)
 	if err != nil {
-		if repository.IsNotFound(err) {
-			return s.createGoogleUser(userInfo)
-		}
 		return nil, err
 	}
 
-	return s.generateTokens(user)
+	return s.completeOAuthLogin(ctx, user, state, device)
 }
 
-// createGoogleUser Google 사용자 생성
-func (s *Service) createGoogleUser(userInfo *pkg.GoogleUserInfo) (*TokenResponse, error) {
-	var user *model.User
-
-	err := s.db.Transaction(func(tx *gorm.DB) error {
-		user = &model.User{
-			Email:      userInfo.Email,
-			Name:       userInfo.Name,
-			Provider:   "google",
-			ProviderID: userInfo.ID,
+// completeOAuthLogin OAuth 로그인 마무리
+// redirect 플로우는 토큰 대신 1회용 로그인 코드를 발급해 URL에 토큰이 남지 않도록 합니다.
+func (s *Service) completeOAuthLogin(ctx context.Context, user *model.User, state *pkg.OAuthState, device *DeviceInfo) (*OAuthCallbackResult, error) {
+	if state.Redirect == "" {
+		token, err := s.generateTokens(ctx, user, device)
+		if err != nil {
+			return nil, err
 		}
+		return &OAuthCallbackResult{Token: token}, nil
+	}
 
-		if err := tx.Create(user).Error; err != nil {
-			return err
-		}
+	loginCode, err := pkg.NewTokenID()
+	if err != nil {
+		return nil, err
+	}
+	if err := s.loginCodes.Save(ctx, loginCode, user.ID, state.CodeChallenge, pkg.LoginCodeTTL); err != nil {
+		return nil, err
+	}
+	return &OAuthCallbackResult{LoginCode: loginCode}, nil
+}
 
-		settings := &model.UserSettings{
-			UserID: user.ID,
-		}
-		return tx.Create(settings).Error
-	})
+// ExchangeLoginCode 1회용 로그인 코드를 토큰으로 교환
+// 로그인을 시작한 클라이언트의 code_verifier가 있어야만 교환할 수 있습니다.
+func (s *Service) ExchangeLoginCode(ctx context.Context, code, codeVerifier string, device *DeviceInfo) (*TokenResponse, error) {
+	if s.loginCodes == nil {
+		return nil, pkg.ErrInvalidLoginCode
+	}
 
+	userID, challenge, err := s.loginCodes.Consume(ctx, code)
 	if err != nil {
 		return nil, err
 	}
 
-	return s.generateTokens(user)
+	if !pkg.VerifyPKCE(codeVerifier, challenge) {
+		return nil, pkg.ErrInvalidLoginCode
+	}
+
+	user, err := s.userRepo.FindByID(userID)
+	if err != nil {
+		return nil, pkg.ErrNotFound
+	}
+
+	return s.generateTokens(ctx, user, device)
 }
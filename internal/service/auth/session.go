@@ -0,0 +1,130 @@
+package auth
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/jptaku/server/internal/model"
+	"github.com/jptaku/server/internal/pkg"
+	"github.com/jptaku/server/internal/repository"
+)
+
+// 기기 플랫폼
+const (
+	PlatformIOS     = "ios"
+	PlatformAndroid = "android"
+	PlatformWeb     = "web"
+	PlatformUnknown = "unknown"
+)
+
+// userAgentMaxLength model.UserSession.UserAgent 컬럼 길이
+const userAgentMaxLength = 512
+
+// ListSessions 로그인된 기기 세션 목록
+func (s *Service) ListSessions(ctx context.Context, userID uint, currentFamilyID string) ([]SessionInfo, error) {
+	sessions, err := s.sessionRepo.ListActive(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]SessionInfo, 0, len(sessions))
+	for _, session := range sessions {
+		result = append(result, SessionInfo{
+			UserSession: session,
+			Current:     currentFamilyID != "" && session.FamilyID == currentFamilyID,
+		})
+	}
+	return result, nil
+}
+
+// RevokeSession 기기 세션 하나를 로그아웃 (해당 기기의 refresh token 폐기)
+func (s *Service) RevokeSession(ctx context.Context, userID, sessionID uint) error {
+	familyID, err := s.sessionRepo.Revoke(userID, sessionID)
+	if err != nil {
+		if repository.IsNotFound(err) {
+			return pkg.ErrNotFound
+		}
+		return err
+	}
+
+	return s.tokenStore.RevokeFamily(ctx, familyID)
+}
+
+// RevokeAllSessions 모든 기기에서 로그아웃
+func (s *Service) RevokeAllSessions(ctx context.Context, userID uint) error {
+	if err := s.sessionRepo.RevokeAll(userID); err != nil {
+		return err
+	}
+	return s.tokenStore.RevokeUser(ctx, userID)
+}
+
+// createSession 새 로그인의 기기 세션 기록
+func (s *Service) createSession(userID uint, familyID string, device *DeviceInfo) error {
+	session := &model.UserSession{
+		UserID:     userID,
+		FamilyID:   familyID,
+		LastSeenAt: time.Now(),
+	}
+	if device != nil {
+		session.UserAgent = truncate(device.UserAgent, userAgentMaxLength)
+		session.Platform = detectPlatform(device)
+		session.IP = device.IP
+	}
+	return s.sessionRepo.Create(session)
+}
+
+// touchSession 토큰 갱신 시 세션 확인 및 마지막 사용 정보 갱신
+// 세션 도입 전에 발급된 토큰은 세션을 새로 만들고, 폐기된 세션이면 family를 폐기합니다.
+func (s *Service) touchSession(ctx context.Context, userID uint, familyID string, device *DeviceInfo) error {
+	session, err := s.sessionRepo.FindByFamilyID(familyID)
+	if err != nil {
+		if repository.IsNotFound(err) {
+			return s.createSession(userID, familyID, device)
+		}
+		return err
+	}
+
+	if session.RevokedAt != nil || session.UserID != userID {
+		if err := s.tokenStore.RevokeFamily(ctx, familyID); err != nil {
+			log.Printf("Failed to revoke token family %s: %v", familyID, err)
+		}
+		return pkg.ErrTokenRevoked
+	}
+
+	if device == nil {
+		device = &DeviceInfo{}
+	}
+	return s.sessionRepo.Touch(session.ID, truncate(device.UserAgent, userAgentMaxLength), detectPlatform(device), device.IP)
+}
+
+// detectPlatform 클라이언트가 보낸 플랫폼 값을 사용하고, 없으면 User-Agent로 추정
+func detectPlatform(device *DeviceInfo) string {
+	switch platform := strings.ToLower(strings.TrimSpace(device.Platform)); platform {
+	case PlatformIOS, PlatformAndroid, PlatformWeb:
+		return platform
+	}
+
+	ua := strings.ToLower(device.UserAgent)
+	switch {
+	case ua == "":
+		return PlatformUnknown
+	case strings.Contains(ua, "iphone"), strings.Contains(ua, "ipad"), strings.Contains(ua, "ios"), strings.Contains(ua, "cfnetwork"):
+		return PlatformIOS
+	case strings.Contains(ua, "android"), strings.Contains(ua, "okhttp"):
+		return PlatformAndroid
+	case strings.Contains(ua, "mozilla"):
+		return PlatformWeb
+	default:
+		return PlatformUnknown
+	}
+}
+
+// truncate 컬럼 길이에 맞게 문자열 자르기
+func truncate(s string, max int) string {
+	if len(s) <= max {
+		return s
+	}
+	return strings.ToValidUTF8(s[:max], "")
+}
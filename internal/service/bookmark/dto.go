@@ -0,0 +1,20 @@
+package bookmark
+
+// AddBookmarkInput 즐겨찾기 추가 입력
+type AddBookmarkInput struct {
+	SentenceID uint
+	FolderID   uint // 0이면 폴더 없음
+	Note       string
+}
+
+// UpdateBookmarkInput 즐겨찾기 수정 입력 (nil이면 변경하지 않음)
+type UpdateBookmarkInput struct {
+	FolderID *uint // 0이면 폴더에서 꺼냄
+	Note     *string
+}
+
+// ReorderBookmarksInput 폴더 안 즐겨찾기 순서 변경 입력
+type ReorderBookmarksInput struct {
+	FolderID    uint   // 0이면 폴더 없는 즐겨찾기
+	SentenceIDs []uint // 새 순서 (폴더 안의 모든 즐겨찾기)
+}
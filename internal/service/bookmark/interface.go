@@ -0,0 +1,45 @@
+package bookmark
+
+import "github.com/jptaku/server/internal/model"
+
+// BookmarkRepository 즐겨찾기 저장소 인터페이스
+type BookmarkRepository interface {
+	FindBookmark(userID, sentenceID uint) (*model.SentenceBookmark, error)
+	CreateBookmark(bookmark *model.SentenceBookmark) error
+	SaveBookmark(bookmark *model.SentenceBookmark) error
+	DeleteBookmark(userID, sentenceID uint) (int64, error)
+	ListBookmarks(userID uint, filter *model.BookmarkFilter, page, perPage int) ([]model.SentenceBookmark, int64, error)
+	NextBookmarkPosition(userID, folderID uint) (int, error)
+	ReorderBookmarks(userID, folderID uint, sentenceIDs []uint) error
+
+	FindFolder(userID, folderID uint) (*model.BookmarkFolder, error)
+	ListFolders(userID uint) ([]model.BookmarkFolder, error)
+	CountFolders(userID uint) (int64, error)
+	CreateFolder(folder *model.BookmarkFolder) error
+	SaveFolder(folder *model.BookmarkFolder) error
+	DeleteFolder(userID, folderID uint) (int64, error)
+}
+
+// SentenceRepository 문장 저장소 인터페이스
+type SentenceRepository interface {
+	FindByID(id uint) (*model.Sentence, error)
+}
+
+// TodayInvalidator 오늘의 문장 응답 캐시 무효화 인터페이스 (sentence.Service)
+type TodayInvalidator interface {
+	InvalidateToday(userID uint)
+}
+
+// Provider 서비스 인터페이스 (외부에서 사용)
+type Provider interface {
+	AddBookmark(userID uint, input *AddBookmarkInput) (*model.SentenceBookmark, error)
+	UpdateBookmark(userID, sentenceID uint, input *UpdateBookmarkInput) (*model.SentenceBookmark, error)
+	RemoveBookmark(userID, sentenceID uint) error
+	ListBookmarks(userID uint, filter *model.BookmarkFilter, page, perPage int) ([]model.SentenceBookmark, int64, error)
+	ReorderBookmarks(userID uint, input *ReorderBookmarksInput) error
+
+	ListFolders(userID uint) ([]model.BookmarkFolder, error)
+	CreateFolder(userID uint, name string) (*model.BookmarkFolder, error)
+	RenameFolder(userID, folderID uint, name string) (*model.BookmarkFolder, error)
+	DeleteFolder(userID, folderID uint) error
+}
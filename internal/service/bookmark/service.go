@@ -0,0 +1,231 @@
+package bookmark
+
+import (
+	"strings"
+
+	"github.com/jptaku/server/internal/model"
+	"github.com/jptaku/server/internal/pkg"
+	"github.com/jptaku/server/internal/repository"
+)
+
+// maxFolders 사용자당 최대 폴더 수
+const maxFolders = 50
+
+// Service 문장 즐겨찾기 서비스
+type Service struct {
+	bookmarkRepo BookmarkRepository
+	sentenceRepo SentenceRepository
+	today        TodayInvalidator
+}
+
+// 컴파일 타임 인터페이스 검증
+var _ Provider = (*Service)(nil)
+
+// NewService 서비스 생성자
+func NewService(bookmarkRepo BookmarkRepository, sentenceRepo SentenceRepository) *Service {
+	return &Service{
+		bookmarkRepo: bookmarkRepo,
+		sentenceRepo: sentenceRepo,
+	}
+}
+
+// SetTodayInvalidator 오늘의 문장 캐시 무효화 설정 (즐겨찾기 여부가 바뀌면 캐시된 응답을 지움)
+func (s *Service) SetTodayInvalidator(today TodayInvalidator) {
+	s.today = today
+}
+
+// invalidateToday 오늘의 문장 캐시 무효화 (설정된 경우)
+func (s *Service) invalidateToday(userID uint) {
+	if s.today != nil {
+		s.today.InvalidateToday(userID)
+	}
+}
+
+// AddBookmark 문장 즐겨찾기 추가 (폴더의 맨 뒤에 추가)
+func (s *Service) AddBookmark(userID uint, input *AddBookmarkInput) (*model.SentenceBookmark, error) {
+	sentence, err := s.sentenceRepo.FindByID(input.SentenceID)
+	if err != nil {
+		if repository.IsNotFound(err) {
+			return nil, pkg.ErrNotFound
+		}
+		return nil, err
+	}
+	if err := s.checkFolder(userID, input.FolderID); err != nil {
+		return nil, err
+	}
+
+	position, err := s.bookmarkRepo.NextBookmarkPosition(userID, input.FolderID)
+	if err != nil {
+		return nil, err
+	}
+
+	bookmark := &model.SentenceBookmark{
+		UserID:     userID,
+		SentenceID: input.SentenceID,
+		FolderID:   folderRef(input.FolderID),
+		Note:       strings.TrimSpace(input.Note),
+		Position:   position,
+	}
+	if err := s.bookmarkRepo.CreateBookmark(bookmark); err != nil {
+		if repository.IsDuplicateError(err) {
+			return nil, pkg.ErrAlreadyBookmarked
+		}
+		return nil, err
+	}
+	s.invalidateToday(userID)
+
+	bookmark.Sentence = sentence
+	return bookmark, nil
+}
+
+// UpdateBookmark 즐겨찾기 메모/폴더 변경 (다른 폴더로 옮기면 그 폴더의 맨 뒤로)
+func (s *Service) UpdateBookmark(userID, sentenceID uint, input *UpdateBookmarkInput) (*model.SentenceBookmark, error) {
+	bookmark, err := s.bookmarkRepo.FindBookmark(userID, sentenceID)
+	if err != nil {
+		if repository.IsNotFound(err) {
+			return nil, pkg.ErrNotFound
+		}
+		return nil, err
+	}
+
+	if input.Note != nil {
+		bookmark.Note = strings.TrimSpace(*input.Note)
+	}
+	if input.FolderID != nil && *input.FolderID != folderValue(bookmark.FolderID) {
+		if err := s.checkFolder(userID, *input.FolderID); err != nil {
+			return nil, err
+		}
+		position, err := s.bookmarkRepo.NextBookmarkPosition(userID, *input.FolderID)
+		if err != nil {
+			return nil, err
+		}
+		bookmark.FolderID = folderRef(*input.FolderID)
+		bookmark.Position = position
+	}
+
+	if err := s.bookmarkRepo.SaveBookmark(bookmark); err != nil {
+		return nil, err
+	}
+	return bookmark, nil
+}
+
+// RemoveBookmark 즐겨찾기 해제
+func (s *Service) RemoveBookmark(userID, sentenceID uint) error {
+	deleted, err := s.bookmarkRepo.DeleteBookmark(userID, sentenceID)
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return pkg.ErrNotFound
+	}
+	s.invalidateToday(userID)
+	return nil
+}
+
+// ListBookmarks 즐겨찾기 목록 (폴더/SubCategory/레벨 필터, 페이지네이션)
+func (s *Service) ListBookmarks(userID uint, filter *model.BookmarkFilter, page, perPage int) ([]model.SentenceBookmark, int64, error) {
+	return s.bookmarkRepo.ListBookmarks(userID, filter, page, perPage)
+}
+
+// ReorderBookmarks 폴더 안 즐겨찾기 순서 변경
+func (s *Service) ReorderBookmarks(userID uint, input *ReorderBookmarksInput) error {
+	if err := s.checkFolder(userID, input.FolderID); err != nil {
+		return err
+	}
+	return s.bookmarkRepo.ReorderBookmarks(userID, input.FolderID, input.SentenceIDs)
+}
+
+// ListFolders 폴더 목록
+func (s *Service) ListFolders(userID uint) ([]model.BookmarkFolder, error) {
+	return s.bookmarkRepo.ListFolders(userID)
+}
+
+// CreateFolder 폴더 생성 (목록의 맨 뒤에 추가)
+func (s *Service) CreateFolder(userID uint, name string) (*model.BookmarkFolder, error) {
+	count, err := s.bookmarkRepo.CountFolders(userID)
+	if err != nil {
+		return nil, err
+	}
+	if count >= maxFolders {
+		return nil, pkg.ErrBadRequest
+	}
+
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, pkg.ErrBadRequest
+	}
+
+	folder := &model.BookmarkFolder{UserID: userID, Name: name}
+	if err := s.bookmarkRepo.CreateFolder(folder); err != nil {
+		if repository.IsDuplicateError(err) {
+			return nil, pkg.ErrDuplicateFolder
+		}
+		return nil, err
+	}
+	return folder, nil
+}
+
+// RenameFolder 폴더 이름 변경
+func (s *Service) RenameFolder(userID, folderID uint, name string) (*model.BookmarkFolder, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, pkg.ErrBadRequest
+	}
+
+	folder, err := s.bookmarkRepo.FindFolder(userID, folderID)
+	if err != nil {
+		if repository.IsNotFound(err) {
+			return nil, pkg.ErrNotFound
+		}
+		return nil, err
+	}
+
+	folder.Name = name
+	if err := s.bookmarkRepo.SaveFolder(folder); err != nil {
+		if repository.IsDuplicateError(err) {
+			return nil, pkg.ErrDuplicateFolder
+		}
+		return nil, err
+	}
+	return folder, nil
+}
+
+// DeleteFolder 폴더 삭제 (안의 즐겨찾기는 폴더 없음으로 이동)
+func (s *Service) DeleteFolder(userID, folderID uint) error {
+	deleted, err := s.bookmarkRepo.DeleteFolder(userID, folderID)
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return pkg.ErrNotFound
+	}
+	return nil
+}
+
+// checkFolder 사용자의 폴더인지 확인 (0은 폴더 없음)
+func (s *Service) checkFolder(userID, folderID uint) error {
+	if folderID == 0 {
+		return nil
+	}
+	if _, err := s.bookmarkRepo.FindFolder(userID, folderID); err != nil {
+		if repository.IsNotFound(err) {
+			return pkg.ErrNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+func folderRef(folderID uint) *uint {
+	if folderID == 0 {
+		return nil
+	}
+	return &folderID
+}
+
+func folderValue(folderID *uint) uint {
+	if folderID == nil {
+		return 0
+	}
+	return *folderID
+}
@@ -1,16 +1,31 @@
 package feedback
 
-import "github.com/jptaku/server/internal/model"
+import (
+	"time"
+
+	"github.com/jptaku/server/internal/model"
+)
 
 // FeedbackRepository 피드백 저장소 인터페이스
 type FeedbackRepository interface {
 	FindBySessionID(sessionID uint) (*model.Feedback, error)
 	Create(feedback *model.Feedback) error
+	AverageScore(userID uint, from, to time.Time) (float64, error)
 }
 
 // ChatRepository 채팅 저장소 인터페이스
 type ChatRepository interface {
-	// 필요한 메서드가 있으면 추가
+	ListSessionsSince(userID uint, since time.Time) ([]model.ChatSession, error)
+}
+
+// LearningRepository 학습 저장소 인터페이스
+type LearningRepository interface {
+	ListCompletedAt(userID uint, since time.Time) ([]time.Time, error)
+}
+
+// UserRepository 사용자 저장소 인터페이스
+type UserRepository interface {
+	GetTimezone(userID uint) (string, error)
 }
 
 // Provider 서비스 인터페이스 (외부에서 사용)
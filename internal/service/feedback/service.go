@@ -1,21 +1,30 @@
 package feedback
 
-import "github.com/jptaku/server/internal/model"
+import (
+	"github.com/jptaku/server/internal/model"
+	"github.com/jptaku/server/internal/pkg"
+)
 
 // Service 피드백 서비스
 type Service struct {
 	feedbackRepo FeedbackRepository
 	chatRepo     ChatRepository
+	learningRepo LearningRepository
+	userRepo     UserRepository
+	calendar     *pkg.Calendar
 }
 
 // 컴파일 타임 인터페이스 검증
 var _ Provider = (*Service)(nil)
 
 // NewService 서비스 생성자
-func NewService(feedbackRepo FeedbackRepository, chatRepo ChatRepository) *Service {
+func NewService(feedbackRepo FeedbackRepository, chatRepo ChatRepository, learningRepo LearningRepository, userRepo UserRepository, calendar *pkg.Calendar) *Service {
 	return &Service{
 		feedbackRepo: feedbackRepo,
 		chatRepo:     chatRepo,
+		learningRepo: learningRepo,
+		userRepo:     userRepo,
+		calendar:     calendar,
 	}
 }
 
@@ -35,16 +44,38 @@ func (s *Service) CreateFeedback(sessionID uint, feedback *model.Feedback) (*mod
 	return feedback, nil
 }
 
-// GetTodayStats 오늘의 통계 조회
+// GetTodayStats 오늘의 통계 조회 (사용자 타임존 기준)
 func (s *Service) GetTodayStats(userID uint) (*StatsResponse, error) {
-	// TODO: 실제 통계 계산 구현
-	return &StatsResponse{
-		TotalSessions:        0,
-		TotalLearningMinutes: 0,
-		TotalSentencesUsed:   0,
-		AverageScore:         0,
-		CurrentStreak:        0,
-	}, nil
+	timezone, _ := s.userRepo.GetTimezone(userID)
+	today := s.calendar.Today(timezone)
+	start, end := s.calendar.DayRange(today, timezone)
+
+	sessions, err := s.chatRepo.ListSessionsSince(userID, start)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &StatsResponse{}
+	var seconds int64
+	for _, session := range sessions {
+		if !session.StartedAt.Before(end) {
+			continue
+		}
+		stats.TotalSessions++
+		stats.TotalSentencesUsed += int64(session.TodaySentenceUsedCount)
+		seconds += int64(session.DurationSeconds)
+	}
+	stats.TotalLearningMinutes = seconds / 60
+
+	if stats.AverageScore, err = s.feedbackRepo.AverageScore(userID, start, end); err != nil {
+		return nil, err
+	}
+
+	if stats.CurrentStreak, err = s.currentStreak(userID, timezone, today); err != nil {
+		return nil, err
+	}
+
+	return stats, nil
 }
 
 // GetCategoryProgress 카테고리별 진행도 조회
@@ -58,8 +89,39 @@ func (s *Service) GetCategoryProgress(userID uint) ([]CategoryProgress, error) {
 	}, nil
 }
 
-// GetWeeklyStats 주간 통계 조회
+// GetWeeklyStats 주간 통계 조회 (사용자 타임존 기준 오늘 포함 최근 7일, 오래된 날짜부터)
 func (s *Service) GetWeeklyStats(userID uint) ([]WeeklyStats, error) {
-	// TODO: 실제 주간 통계 계산 구현
-	return []WeeklyStats{}, nil
+	timezone, _ := s.userRepo.GetTimezone(userID)
+	days := s.calendar.LastDays(7, timezone)
+	since, _ := s.calendar.DayRange(days[0], timezone)
+
+	sessions, err := s.chatRepo.ListSessionsSince(userID, since)
+	if err != nil {
+		return nil, err
+	}
+	completed, err := s.learningRepo.ListCompletedAt(userID, since)
+	if err != nil {
+		return nil, err
+	}
+
+	byDate := make(map[string]*WeeklyStats, len(days))
+	stats := make([]WeeklyStats, len(days))
+	for i, day := range days {
+		stats[i].Date = day.Format(pkg.DateLayout)
+		byDate[stats[i].Date] = &stats[i]
+	}
+
+	for _, session := range sessions {
+		if day, ok := byDate[s.calendar.DateOf(session.StartedAt, timezone).Format(pkg.DateLayout)]; ok {
+			day.SessionCount++
+			day.MinutesSpent += session.DurationSeconds / 60
+		}
+	}
+	for _, t := range completed {
+		if day, ok := byDate[s.calendar.DateOf(t, timezone).Format(pkg.DateLayout)]; ok {
+			day.SentencesLearned++
+		}
+	}
+
+	return stats, nil
 }
@@ -0,0 +1,34 @@
+package feedback
+
+import "time"
+
+// streakLookbackDays streak 계산 시 조회하는 최대 기간
+const streakLookbackDays = 366
+
+// currentStreak 연속 학습 일수
+// 하루에 한 문장 이상 암기를 완료하면 학습한 날로 셉니다.
+// 오늘 아직 학습하지 않았으면 어제까지의 연속 일수를 유지합니다.
+func (s *Service) currentStreak(userID uint, timezone string, today time.Time) (int, error) {
+	since, _ := s.calendar.DayRange(today.AddDate(0, 0, -streakLookbackDays), timezone)
+	completed, err := s.learningRepo.ListCompletedAt(userID, since)
+	if err != nil {
+		return 0, err
+	}
+
+	studied := make(map[time.Time]bool, len(completed))
+	for _, t := range completed {
+		studied[s.calendar.DateOf(t, timezone)] = true
+	}
+
+	day := today
+	if !studied[day] {
+		day = day.AddDate(0, 0, -1)
+	}
+
+	streak := 0
+	for studied[day] {
+		streak++
+		day = day.AddDate(0, 0, -1)
+	}
+	return streak, nil
+}
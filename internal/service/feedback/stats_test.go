@@ -0,0 +1,97 @@
+package feedback
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jptaku/server/internal/pkg"
+)
+
+// fakeLearningRepo 암기 완료 시각 목록을 그대로 돌려주는 저장소
+type fakeLearningRepo struct {
+	completed []time.Time
+}
+
+func (r *fakeLearningRepo) ListCompletedAt(_ uint, since time.Time) ([]time.Time, error) {
+	var result []time.Time
+	for _, t := range r.completed {
+		if !t.Before(since) {
+			result = append(result, t)
+		}
+	}
+	return result, nil
+}
+
+// kst KST 시각을 UTC로
+func kst(y int, m time.Month, d, hour, min int) time.Time {
+	return time.Date(y, m, d, hour, min, 0, 0, time.FixedZone("KST", 9*60*60)).UTC()
+}
+
+func TestCurrentStreak(t *testing.T) {
+	tests := []struct {
+		name      string
+		now       time.Time
+		completed []time.Time
+		want      int
+	}{
+		{"never studied", kst(2026, 3, 10, 12, 0), nil, 0},
+		{"studied today only", kst(2026, 3, 10, 12, 0), []time.Time{kst(2026, 3, 10, 9, 0)}, 1},
+		{
+			"three days including today",
+			kst(2026, 3, 10, 12, 0),
+			[]time.Time{kst(2026, 3, 8, 21, 0), kst(2026, 3, 9, 8, 0), kst(2026, 3, 10, 7, 0)},
+			3,
+		},
+		{
+			"not studied yet today keeps yesterday's streak",
+			kst(2026, 3, 10, 12, 0),
+			[]time.Time{kst(2026, 3, 8, 21, 0), kst(2026, 3, 9, 8, 0)},
+			2,
+		},
+		{
+			"missed yesterday",
+			kst(2026, 3, 10, 12, 0),
+			[]time.Time{kst(2026, 3, 7, 21, 0), kst(2026, 3, 8, 8, 0)},
+			0,
+		},
+		{
+			"gap breaks the streak",
+			kst(2026, 3, 10, 12, 0),
+			[]time.Time{kst(2026, 3, 6, 10, 0), kst(2026, 3, 8, 10, 0), kst(2026, 3, 9, 10, 0), kst(2026, 3, 10, 10, 0)},
+			3,
+		},
+		{
+			"several completions on one day count once",
+			kst(2026, 3, 10, 12, 0),
+			[]time.Time{kst(2026, 3, 9, 8, 0), kst(2026, 3, 9, 9, 0), kst(2026, 3, 10, 10, 0), kst(2026, 3, 10, 11, 0)},
+			2,
+		},
+		{
+			// 23:30 KST는 UTC로는 같은 날 14:30, 00:10 KST는 UTC로 전날 15:10
+			"days follow KST midnight, not UTC",
+			kst(2026, 3, 10, 0, 30),
+			[]time.Time{kst(2026, 3, 8, 23, 30), kst(2026, 3, 9, 0, 10), kst(2026, 3, 10, 0, 5)},
+			3,
+		},
+		{
+			"just after KST midnight before studying",
+			kst(2026, 3, 10, 0, 1),
+			[]time.Time{kst(2026, 3, 8, 22, 0), kst(2026, 3, 9, 23, 59)},
+			2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calendar := pkg.NewCalendar(pkg.ClockFunc(func() time.Time { return tt.now }), "UTC")
+			s := &Service{learningRepo: &fakeLearningRepo{completed: tt.completed}, calendar: calendar}
+
+			got, err := s.currentStreak(1, "Asia/Seoul", calendar.Today("Asia/Seoul"))
+			if err != nil {
+				t.Fatalf("currentStreak() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("currentStreak() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
@@ -1,6 +1,11 @@
 package learning
 
-import "github.com/jptaku/server/internal/model"
+import (
+	"time"
+
+	"github.com/jptaku/server/internal/model"
+	"github.com/jptaku/server/internal/pkg"
+)
 
 // UpdateProgressInput 진행 상황 업데이트 입력
 type UpdateProgressInput struct {
@@ -21,18 +26,55 @@ type TodayProgressResponse struct {
 }
 
 // SubmitQuizInput 퀴즈 제출 입력
+// 문장 배열 답안(Order)은 화면에 보인 조각(섞인 순서)의 인덱스입니다.
 type SubmitQuizInput struct {
-	SentenceID      uint
-	DailySetID      uint
-	FillBlankAnswer string
-	OrderingAnswer  []int
+	SentenceID uint
+	DailySetID uint
+	Answers    map[pkg.QuizType]model.QuizAnswer // 제출한 유형별 답 (풀지 않은 유형은 빠짐)
+	TimeSpent  int                               // 풀이 소요 시간 (초, 클라이언트 보고값)
+	Legacy     bool                              // 기존 클라이언트 요청 (fill_blank_answer/ordering_answer만 보냄, 기존 유형만으로 완료 판정)
 }
 
 // SubmitQuizResult 퀴즈 제출 결과
 type SubmitQuizResult struct {
 	SentenceID       uint
+	Parts            []QuizPartResult // 채점한 유형별 결과 (pkg.AllQuizTypes 순서)
 	FillBlankCorrect bool
 	OrderingCorrect  bool
-	AllCorrect       bool
+	Complete         bool // 문장에 있는 모든 유형을 제출했는지 (아니면 학습 상태/복습 일정에 반영하지 않음)
+	AllCorrect       bool // 모든 유형을 제출해 모두 맞혔는지
 	Memorized        bool
+	AttemptNo        int        // 이 문장에서 몇 번째 시도인지
+	FillBlankAnswer  string     // 빈칸 채우기 정답 (제출 후 공개)
+	OrderingAnswer   []int      // 문장 배열 정답 순서 (화면에 보인 조각의 인덱스, 제출 후 공개)
+	NextReviewAt     *time.Time // 다음 복습 시각 (복습 일정이 없으면 nil)
+}
+
+// QuizPartResult 유형별 채점 결과
+type QuizPartResult struct {
+	Type          pkg.QuizType
+	Correct       bool
+	Match         pkg.AnswerMatch     // exact, acceptable(표기만 다름), wrong
+	CorrectAnswer model.QuizAnswer    // 정답 (제출 후 공개, 문장 배열은 화면에 보인 조각의 인덱스)
+	Diffs         [][]pkg.DiffSegment // 입력한 답과 정답의 차이 (입력형 답만, 단어 읽기는 문항별, 똑같으면 nil)
+}
+
+// QuizSubject 채점할 퀴즈 (사용자별로 섞인 순서를 되돌리는 데 필요한 정보 포함)
+type QuizSubject struct {
+	Quiz       *model.Quiz
+	SentenceID uint
+	Seed       int64 // 사용자 퀴즈 시드
+}
+
+// Permutation 이 사용자에게 보인 quizType 보기/조각 순서 (pkg.QuizPermutation)
+func (s *QuizSubject) Permutation(quizType pkg.QuizType, n int) []int {
+	return pkg.QuizPermutation(s.Seed, s.SentenceID, quizType, n)
+}
+
+// QuizGrade 채점기 결과 (Match가 exact 또는 acceptable이면 정답)
+type QuizGrade struct {
+	Match    pkg.AnswerMatch
+	Recorded model.QuizAnswer    // 시도 기록에 남길 답 (섞인 인덱스는 원래 인덱스로 되돌린 값)
+	Reveal   model.QuizAnswer    // 공개할 정답 (화면 기준)
+	Diffs    [][]pkg.DiffSegment // 입력한 답과 정답의 차이 (답마다 하나, 차이가 없거나 보기 선택이면 nil)
 }
@@ -1,6 +1,9 @@
 package learning
 
-import "github.com/jptaku/server/internal/model"
+import (
+	"github.com/jptaku/server/internal/model"
+	"github.com/jptaku/server/internal/pkg"
+)
 
 // LearningRepository 학습 저장소 인터페이스
 type LearningRepository interface {
@@ -16,10 +19,40 @@ type SentenceRepository interface {
 	GetDetail(sentenceID uint) (*model.SentenceDetail, error)
 }
 
+// QuizAttemptRepository 퀴즈 시도 기록 저장소 인터페이스
+type QuizAttemptRepository interface {
+	Create(attempt *model.QuizAttempt) error
+	ListByUser(userID, sentenceID uint, page, perPage int) ([]model.QuizAttempt, int64, error)
+}
+
+// UserRepository 사용자 저장소 인터페이스
+type UserRepository interface {
+	GetQuizSeed(userID uint) (int64, error)
+}
+
+// ReviewScheduler 복습 일정 관리 인터페이스 (review.Service)
+type ReviewScheduler interface {
+	Start(userID, sentenceID uint) (*model.SentenceReview, error)
+	RecordQuizResult(userID, sentenceID uint, grade pkg.ReviewGrade) (*model.SentenceReview, error)
+}
+
+// TodayInvalidator 오늘의 문장 응답 캐시 무효화 인터페이스 (sentence.Service)
+type TodayInvalidator interface {
+	InvalidateToday(userID uint)
+}
+
+// QuizGrader 퀴즈 유형별 채점기 (Service.RegisterGrader로 추가/교체)
+type QuizGrader interface {
+	Type() pkg.QuizType
+	Has(quiz *model.Quiz) bool // 퀴즈에 이 유형 문제가 있는지
+	Grade(subject *QuizSubject, answer model.QuizAnswer) QuizGrade
+}
+
 // Provider 서비스 인터페이스 (외부에서 사용)
 type Provider interface {
 	UpdateProgress(userID uint, input *UpdateProgressInput) (*model.LearningProgress, error)
 	GetTodayProgress(userID, dailySetID uint) (*TodayProgressResponse, error)
 	GetProgress(userID uint, page, perPage int) ([]model.LearningProgress, int64, error)
 	SubmitQuiz(userID uint, input *SubmitQuizInput) (*SubmitQuizResult, error)
+	GetQuizAttempts(userID, sentenceID uint, page, perPage int) ([]model.QuizAttempt, int64, error)
 }
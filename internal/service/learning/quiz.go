@@ -0,0 +1,264 @@
+package learning
+
+import (
+	"github.com/jptaku/server/internal/model"
+	"github.com/jptaku/server/internal/pkg"
+)
+
+// defaultGraders 기본 채점기 (pkg.AllQuizTypes의 모든 유형)
+func defaultGraders() []QuizGrader {
+	return []QuizGrader{
+		fillBlankGrader{},
+		orderingGrader{},
+		choiceGrader{quizType: pkg.QuizTypeMeaning, choice: func(q *model.Quiz) *model.QuizChoice { return q.Meaning }},
+		choiceGrader{quizType: pkg.QuizTypeListening, choice: func(q *model.Quiz) *model.QuizChoice { return q.Listening }},
+		dictationGrader{},
+		readingGrader{},
+	}
+}
+
+// gradedPart 유형별 채점 결과
+type gradedPart struct {
+	quizType pkg.QuizType
+	answer   model.QuizAnswer
+	grade    QuizGrade
+}
+
+// gradeQuiz 제출한 유형을 각 채점기로 채점 (pkg.AllQuizTypes 순서, 퀴즈에 없는 유형은 건너뜀)
+func (s *Service) gradeQuiz(subject *QuizSubject, answers map[pkg.QuizType]model.QuizAnswer) []gradedPart {
+	var parts []gradedPart
+	for _, quizType := range pkg.AllQuizTypes {
+		answer, ok := answers[quizType]
+		grader := s.graders[quizType]
+		if !ok || grader == nil || !grader.Has(subject.Quiz) {
+			continue
+		}
+		parts = append(parts, gradedPart{
+			quizType: quizType,
+			answer:   answer,
+			grade:    grader.Grade(subject, answer),
+		})
+	}
+	return parts
+}
+
+// legacyQuizTypes 기존 클라이언트(fill_blank_answer/ordering_answer)가 푸는 유형
+var legacyQuizTypes = []pkg.QuizType{pkg.QuizTypeFillBlank, pkg.QuizTypeOrdering}
+
+// isComplete 퀴즈에 있는 유형(채점기가 등록된 유형)을 모두 제출했는지
+// 기존 클라이언트 요청은 나중에 추가된 유형을 풀 수 없으므로 기존 유형만 기준으로 봅니다.
+func (s *Service) isComplete(quiz *model.Quiz, parts []gradedPart, legacy bool) bool {
+	submitted := make(map[pkg.QuizType]bool, len(parts))
+	for _, part := range parts {
+		submitted[part.quizType] = true
+	}
+
+	required := pkg.AllQuizTypes
+	if legacy {
+		required = legacyQuizTypes
+	}
+	for _, quizType := range required {
+		if grader := s.graders[quizType]; grader != nil && grader.Has(quiz) && !submitted[quizType] {
+			return false
+		}
+	}
+	return true
+}
+
+// fillBlankGrader 빈칸 채우기 채점
+type fillBlankGrader struct{}
+
+func (fillBlankGrader) Type() pkg.QuizType { return pkg.QuizTypeFillBlank }
+
+func (fillBlankGrader) Has(quiz *model.Quiz) bool { return quiz.FillBlank != nil }
+
+func (fillBlankGrader) Grade(subject *QuizSubject, answer model.QuizAnswer) QuizGrade {
+	return textGrade(answer.Text, subject.Quiz.FillBlank.Answer, pkg.JapaneseNormalizeOptions{})
+}
+
+// orderingGrader 문장 배열 채점
+// 답안은 사용자에게 섞여 보인 조각의 인덱스이므로, 같은 시드로 원래 인덱스로 되돌려 비교합니다.
+type orderingGrader struct{}
+
+func (orderingGrader) Type() pkg.QuizType { return pkg.QuizTypeOrdering }
+
+func (orderingGrader) Has(quiz *model.Quiz) bool { return quiz.Ordering != nil }
+
+func (orderingGrader) Grade(subject *QuizSubject, answer model.QuizAnswer) QuizGrade {
+	ordering := subject.Quiz.Ordering
+	perm := subject.Permutation(pkg.QuizTypeOrdering, len(ordering.Fragments))
+	answered := unshuffle(answer.Order, perm)
+
+	// 화면 인덱스 기준 정답 (원래 인덱스 -> 화면 인덱스)
+	shown := make([]int, len(perm))
+	for i, idx := range perm {
+		shown[idx] = i
+	}
+	reveal := make([]int, 0, len(ordering.CorrectOrder))
+	for _, idx := range ordering.CorrectOrder {
+		if idx >= 0 && idx < len(shown) {
+			reveal = append(reveal, shown[idx])
+		}
+	}
+
+	match := pkg.AnswerWrong
+	if compareIntSlices(ordering.CorrectOrder, answered) {
+		match = pkg.AnswerExact
+	}
+	return QuizGrade{
+		Match:    match,
+		Recorded: model.QuizAnswer{Order: answered},
+		Reveal:   model.QuizAnswer{Order: reveal},
+	}
+}
+
+// choiceGrader 보기 고르기 채점 (뜻 고르기, 듣기)
+// 보기 순서만 섞이고 답은 보기 문자열로 받으므로 순서를 되돌릴 필요가 없습니다.
+// 고른 보기를 그대로 보내므로 차이는 돌려주지 않습니다.
+type choiceGrader struct {
+	quizType pkg.QuizType
+	choice   func(*model.Quiz) *model.QuizChoice
+}
+
+func (g choiceGrader) Type() pkg.QuizType { return g.quizType }
+
+func (g choiceGrader) Has(quiz *model.Quiz) bool { return g.choice(quiz) != nil }
+
+func (g choiceGrader) Grade(subject *QuizSubject, answer model.QuizAnswer) QuizGrade {
+	grade := textGrade(answer.Text, g.choice(subject.Quiz).Answer, pkg.JapaneseNormalizeOptions{})
+	grade.Diffs = nil
+	return grade
+}
+
+// dictationGrader 받아쓰기 채점
+type dictationGrader struct{}
+
+func (dictationGrader) Type() pkg.QuizType { return pkg.QuizTypeDictation }
+
+func (dictationGrader) Has(quiz *model.Quiz) bool { return quiz.Dictation != nil }
+
+func (dictationGrader) Grade(subject *QuizSubject, answer model.QuizAnswer) QuizGrade {
+	return textGrade(answer.Text, subject.Quiz.Dictation.Answer, pkg.JapaneseNormalizeOptions{})
+}
+
+// readingGrader 단어 읽기 채점 (모든 문항을 맞혀야 정답)
+// 정답이 가나뿐이므로 로마지 입력도 가나로 바꿔 비교합니다.
+type readingGrader struct{}
+
+func (readingGrader) Type() pkg.QuizType { return pkg.QuizTypeReading }
+
+func (readingGrader) Has(quiz *model.Quiz) bool {
+	return quiz.Reading != nil && len(quiz.Reading.Items) > 0
+}
+
+func (readingGrader) Grade(subject *QuizSubject, answer model.QuizAnswer) QuizGrade {
+	items := subject.Quiz.Reading.Items
+	grade := QuizGrade{
+		Match:    pkg.AnswerExact,
+		Recorded: model.QuizAnswer{Texts: answer.Texts},
+		Reveal:   model.QuizAnswer{Texts: make([]string, len(items))},
+		Diffs:    make([][]pkg.DiffSegment, len(items)),
+	}
+	for i, item := range items {
+		grade.Reveal.Texts[i] = item.Reading
+
+		var text string
+		if i < len(answer.Texts) {
+			text = answer.Texts[i]
+		}
+		result := pkg.GradeJapaneseAnswer(text, item.Reading, pkg.JapaneseNormalizeOptions{Romaji: true})
+		grade.Diffs[i] = result.Diff
+		grade.Match = worseMatch(grade.Match, result.Match)
+	}
+	if len(answer.Texts) > len(items) {
+		grade.Match = pkg.AnswerWrong
+	}
+	if grade.Match == pkg.AnswerExact {
+		grade.Diffs = nil
+	}
+	return grade
+}
+
+// textGrade 입력한 답을 정답과 비교 (pkg.GradeJapaneseAnswer, 표기만 다른 답도 정답으로 인정)
+func textGrade(answer, correct string, opts pkg.JapaneseNormalizeOptions) QuizGrade {
+	result := pkg.GradeJapaneseAnswer(answer, correct, opts)
+	grade := QuizGrade{
+		Match:    result.Match,
+		Recorded: model.QuizAnswer{Text: answer},
+		Reveal:   model.QuizAnswer{Text: correct},
+	}
+	if result.Diff != nil {
+		grade.Diffs = [][]pkg.DiffSegment{result.Diff}
+	}
+	return grade
+}
+
+// worseMatch 두 채점 결과 중 낮은 쪽 (wrong < acceptable < exact)
+func worseMatch(a, b pkg.AnswerMatch) pkg.AnswerMatch {
+	switch {
+	case a == pkg.AnswerWrong || b == pkg.AnswerWrong:
+		return pkg.AnswerWrong
+	case a == pkg.AnswerAcceptable || b == pkg.AnswerAcceptable:
+		return pkg.AnswerAcceptable
+	default:
+		return pkg.AnswerExact
+	}
+}
+
+// maxFillBlankAnswerLength quiz_attempts.fill_blank_answer 컬럼 길이 (글자 수)
+const maxFillBlankAnswerLength = 255
+
+// truncate 컬럼 길이(글자 수)에 맞게 문자열 자르기
+func truncate(s string, max int) string {
+	runes := []rune(s)
+	if len(runes) <= max {
+		return s
+	}
+	return string(runes[:max])
+}
+
+// maxTimeSpentSeconds 기록할 최대 풀이 시간 (자리를 비운 경우 등으로 통계가 튀지 않도록)
+const maxTimeSpentSeconds = 3600
+
+// clampTimeSpent 클라이언트가 보고한 풀이 시간을 0~maxTimeSpentSeconds로 제한
+func clampTimeSpent(seconds int) int {
+	return min(max(seconds, 0), maxTimeSpentSeconds)
+}
+
+// unshuffle 화면 인덱스를 원래 인덱스로 변환 (범위를 벗어난 인덱스는 -1)
+func unshuffle(answer []int, perm []int) []int {
+	result := make([]int, len(answer))
+	for i, idx := range answer {
+		if idx < 0 || idx >= len(perm) {
+			result[i] = -1
+			continue
+		}
+		result[i] = perm[idx]
+	}
+	return result
+}
+
+// quizGrade 퀴즈 결과를 복습 평가로 변환 (모두 정답: good, 일부 정답: hard, 모두 오답: again)
+func quizGrade(correct, total int) pkg.ReviewGrade {
+	switch {
+	case total > 0 && correct == total:
+		return pkg.ReviewGood
+	case correct > 0:
+		return pkg.ReviewHard
+	default:
+		return pkg.ReviewAgain
+	}
+}
+
+// compareIntSlices 두 int 슬라이스가 같은지 비교
+func compareIntSlices(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
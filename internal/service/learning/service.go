@@ -4,6 +4,8 @@ import (
 	"time"
 
 	"github.com/jptaku/server/internal/model"
+	"github.com/jptaku/server/internal/pkg"
+	"github.com/jptaku/server/internal/repository"
 	"gorm.io/gorm"
 )
 
@@ -11,20 +13,51 @@ import (
 type Service struct {
 	learningRepo LearningRepository
 	sentenceRepo SentenceRepository
+	userRepo     UserRepository
+	attemptRepo  QuizAttemptRepository
+	reviews      ReviewScheduler
+	today        TodayInvalidator
+	graders      map[pkg.QuizType]QuizGrader
 }
 
 // 컴파일 타임 인터페이스 검증
 var _ Provider = (*Service)(nil)
 
 // NewService 서비스 생성자
-func NewService(learningRepo LearningRepository, sentenceRepo SentenceRepository) *Service {
-	return &Service{
+func NewService(learningRepo LearningRepository, sentenceRepo SentenceRepository, userRepo UserRepository, attemptRepo QuizAttemptRepository, reviews ReviewScheduler) *Service {
+	s := &Service{
 		learningRepo: learningRepo,
 		sentenceRepo: sentenceRepo,
+		userRepo:     userRepo,
+		attemptRepo:  attemptRepo,
+		reviews:      reviews,
+		graders:      make(map[pkg.QuizType]QuizGrader),
+	}
+	for _, grader := range defaultGraders() {
+		s.RegisterGrader(grader)
+	}
+	return s
+}
+
+// RegisterGrader 퀴즈 유형 채점기 등록 (같은 유형이 있으면 교체)
+func (s *Service) RegisterGrader(grader QuizGrader) {
+	s.graders[grader.Type()] = grader
+}
+
+// SetTodayInvalidator 오늘의 문장 캐시 무효화 설정 (암기 여부가 바뀌면 캐시된 응답을 지움)
+func (s *Service) SetTodayInvalidator(today TodayInvalidator) {
+	s.today = today
+}
+
+// invalidateToday 오늘의 문장 캐시 무효화 (설정된 경우)
+func (s *Service) invalidateToday(userID uint) {
+	if s.today != nil {
+		s.today.InvalidateToday(userID)
 	}
 }
 
 // UpdateProgress 진행 상황 업데이트
+// 암기 완료로 표시하면 복습 일정이 시작됩니다.
 func (s *Service) UpdateProgress(userID uint, input *UpdateProgressInput) (*model.LearningProgress, error) {
 	progress, err := s.learningRepo.FindByUserAndSentence(userID, input.SentenceID)
 	if err != nil {
@@ -65,6 +98,13 @@ func (s *Service) UpdateProgress(userID uint, input *UpdateProgressInput) (*mode
 			return nil, err
 		}
 	}
+	s.invalidateToday(userID)
+
+	if progress.Memorized {
+		if _, err := s.reviews.Start(userID, input.SentenceID); err != nil {
+			return nil, err
+		}
+	}
 
 	return progress, nil
 }
@@ -104,27 +144,99 @@ func (s *Service) GetProgress(userID uint, page, perPage int) ([]model.LearningP
 }
 
 // SubmitQuiz 퀴즈 제출 및 정답 검증
+// 제출한 유형마다 등록된 채점기로 채점하며, 정답은 제출한 뒤에야 결과와 함께 공개됩니다.
+// 퀴즈에 없는 유형의 답은 무시하고, 채점할 답이 하나도 없으면 pkg.ErrBadRequest를 반환합니다.
+// 문장에 있는 모든 유형을 제출해야 모두 정답(암기 완료)으로 인정하고 복습 평가에 반영합니다
+// (모두 정답: good, 일부 정답: hard, 모두 오답: again). 일부 유형만 제출하면 시도 기록만 남깁니다.
+// 기존 클라이언트 요청(input.Legacy)은 빈칸 채우기와 문장 배열만 제출하면 완료로 봅니다.
 func (s *Service) SubmitQuiz(userID uint, input *SubmitQuizInput) (*SubmitQuizResult, error) {
 	detail, err := s.sentenceRepo.GetDetail(input.SentenceID)
 	if err != nil {
+		if repository.IsNotFound(err) {
+			return nil, pkg.ErrNotFound
+		}
 		return nil, err
 	}
+	if detail.Quiz == nil {
+		return nil, pkg.ErrNotFound
+	}
 
-	if detail == nil || detail.Quiz == nil {
+	seed, err := s.userRepo.GetQuizSeed(userID)
+	if err != nil {
 		return nil, err
 	}
+	subject := &QuizSubject{Quiz: detail.Quiz, SentenceID: input.SentenceID, Seed: seed}
+	parts := s.gradeQuiz(subject, input.Answers)
+	if len(parts) == 0 {
+		return nil, pkg.ErrBadRequest
+	}
+
+	attempt := &model.QuizAttempt{
+		UserID:           userID,
+		SentenceID:       input.SentenceID,
+		DailySetID:       input.DailySetID,
+		TimeSpentSeconds: clampTimeSpent(input.TimeSpent),
+		AttemptedAt:      time.Now(),
+	}
+	result := &SubmitQuizResult{SentenceID: input.SentenceID}
+	correctCount := 0
+	for _, part := range parts {
+		correct := part.grade.Match.IsCorrect()
+		if correct {
+			correctCount++
+		}
+		attempt.Parts = append(attempt.Parts, model.QuizAttemptPart{
+			Type:    string(part.quizType),
+			Answer:  part.grade.Recorded,
+			Correct: correct,
+			Match:   string(part.grade.Match),
+		})
+		result.Parts = append(result.Parts, QuizPartResult{
+			Type:          part.quizType,
+			Correct:       correct,
+			Match:         part.grade.Match,
+			CorrectAnswer: part.grade.Reveal,
+			Diffs:         part.grade.Diffs,
+		})
 
-	fillBlankCorrect := false
-	if detail.Quiz.FillBlank != nil {
-		fillBlankCorrect = detail.Quiz.FillBlank.Answer == input.FillBlankAnswer
+		// 빈칸 채우기/문장 배열은 통계용 컬럼과 기존 응답 필드에도 남김
+		switch part.quizType {
+		case pkg.QuizTypeFillBlank:
+			attempt.FillBlankAnswer = truncate(part.grade.Recorded.Text, maxFillBlankAnswerLength)
+			attempt.FillBlankCorrect = correct
+			result.FillBlankCorrect = correct
+			result.FillBlankAnswer = part.grade.Reveal.Text
+		case pkg.QuizTypeOrdering:
+			attempt.OrderingAnswer = part.grade.Recorded.Order
+			attempt.OrderingCorrect = correct
+			result.OrderingCorrect = correct
+			result.OrderingAnswer = part.grade.Reveal.Order
+		}
 	}
+	complete := s.isComplete(detail.Quiz, parts, input.Legacy)
+	allCorrect := complete && correctCount == len(parts)
+	attempt.Complete = complete
+	attempt.AllCorrect = allCorrect
+	result.Complete = complete
+	result.AllCorrect = allCorrect
 
-	orderingCorrect := false
-	if detail.Quiz.Ordering != nil {
-		orderingCorrect = compareIntSlices(detail.Quiz.Ordering.CorrectOrder, input.OrderingAnswer)
+	if err := s.attemptRepo.Create(attempt); err != nil {
+		return nil, err
 	}
+	result.AttemptNo = attempt.AttemptNo
 
-	allCorrect := fillBlankCorrect && orderingCorrect
+	// 일부 유형만 제출하면 학습 상태와 복습 일정은 그대로 둠
+	if !complete {
+		progress, err := s.learningRepo.FindByUserAndSentence(userID, input.SentenceID)
+		if err != nil && err != gorm.ErrRecordNotFound {
+			return nil, err
+		}
+		if progress != nil {
+			result.Memorized = progress.Memorized
+		}
+		s.invalidateToday(userID)
+		return result, nil
+	}
 
 	progress, err := s.learningRepo.FindByUserAndSentence(userID, input.SentenceID)
 	if err != nil {
@@ -156,25 +268,29 @@ func (s *Service) SubmitQuiz(userID uint, input *SubmitQuizInput) (*SubmitQuizRe
 			return nil, err
 		}
 	}
+	s.invalidateToday(userID)
 
-	return &SubmitQuizResult{
-		SentenceID:       input.SentenceID,
-		FillBlankCorrect: fillBlankCorrect,
-		OrderingCorrect:  orderingCorrect,
-		AllCorrect:       allCorrect,
-		Memorized:        progress.Memorized,
-	}, nil
+	result.Memorized = progress.Memorized
+
+	review, err := s.reviews.RecordQuizResult(userID, input.SentenceID, quizGrade(correctCount, len(parts)))
+	if err != nil {
+		return nil, err
+	}
+	if review != nil {
+		result.NextReviewAt = &review.DueAt
+	}
+
+	return result, nil
 }
 
-// compareIntSlices 두 int 슬라이스가 같은지 비교
-func compareIntSlices(a, b []int) bool {
-	if len(a) != len(b) {
-		return false
+// GetQuizAttempts 퀴즈 시도 기록 조회 (sentenceID가 0이 아니면 그 문장만)
+func (s *Service) GetQuizAttempts(userID, sentenceID uint, page, perPage int) ([]model.QuizAttempt, int64, error) {
+	if page < 1 {
+		page = 1
 	}
-	for i := range a {
-		if a[i] != b[i] {
-			return false
-		}
+	if perPage < 1 || perPage > 50 {
+		perPage = 20
 	}
-	return true
+
+	return s.attemptRepo.ListByUser(userID, sentenceID, page, perPage)
 }
@@ -0,0 +1,211 @@
+package learning
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jptaku/server/internal/model"
+	"github.com/jptaku/server/internal/pkg"
+	"gorm.io/gorm"
+)
+
+const (
+	testUserID     uint  = 1
+	testSentenceID uint  = 10
+	testQuizSeed   int64 = 42
+)
+
+// fakeLearningRepo 진행 기록 하나만 들고 있는 저장소
+type fakeLearningRepo struct {
+	progress *model.LearningProgress
+}
+
+func (r *fakeLearningRepo) FindByUserAndSentence(_, _ uint) (*model.LearningProgress, error) {
+	if r.progress == nil {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return r.progress, nil
+}
+
+func (r *fakeLearningRepo) Create(progress *model.LearningProgress) error {
+	progress.ID = 1
+	r.progress = progress
+	return nil
+}
+
+func (r *fakeLearningRepo) Update(progress *model.LearningProgress) error {
+	r.progress = progress
+	return nil
+}
+
+func (r *fakeLearningRepo) GetTodayProgress(_, _ uint) ([]model.LearningProgress, error) {
+	return nil, nil
+}
+
+func (r *fakeLearningRepo) GetUserProgress(_ uint, _, _ int) ([]model.LearningProgress, int64, error) {
+	return nil, 0, nil
+}
+
+type fakeSentenceRepo struct {
+	detail *model.SentenceDetail
+}
+
+func (r *fakeSentenceRepo) GetDetail(_ uint) (*model.SentenceDetail, error) {
+	return r.detail, nil
+}
+
+type fakeUserRepo struct{}
+
+func (fakeUserRepo) GetQuizSeed(_ uint) (int64, error) { return testQuizSeed, nil }
+
+// fakeAttemptRepo 제출 기록을 순서대로 쌓는 저장소
+type fakeAttemptRepo struct {
+	attempts []*model.QuizAttempt
+}
+
+func (r *fakeAttemptRepo) Create(attempt *model.QuizAttempt) error {
+	attempt.AttemptNo = len(r.attempts) + 1
+	r.attempts = append(r.attempts, attempt)
+	return nil
+}
+
+func (r *fakeAttemptRepo) ListByUser(_, _ uint, _, _ int) ([]model.QuizAttempt, int64, error) {
+	return nil, 0, nil
+}
+
+// fakeReviews 복습 채점 결과만 기록하는 스케줄러
+type fakeReviews struct {
+	grades []pkg.ReviewGrade
+}
+
+func (r *fakeReviews) Start(userID, sentenceID uint) (*model.SentenceReview, error) {
+	return &model.SentenceReview{UserID: userID, SentenceID: sentenceID}, nil
+}
+
+func (r *fakeReviews) RecordQuizResult(userID, sentenceID uint, grade pkg.ReviewGrade) (*model.SentenceReview, error) {
+	r.grades = append(r.grades, grade)
+	return &model.SentenceReview{UserID: userID, SentenceID: sentenceID, DueAt: time.Now().AddDate(0, 0, 1)}, nil
+}
+
+// fullQuiz 기존 유형(빈칸 채우기, 문장 배열)에 나중에 추가된 유형까지 모두 있는 퀴즈
+func fullQuiz() *model.Quiz {
+	return &model.Quiz{
+		FillBlank: &model.QuizFillBlank{QuestionJP: "＿＿を食べる", Options: []string{"ごはん", "みず", "ほん"}, Answer: "ごはん"},
+		Ordering:  &model.QuizOrdering{Fragments: []string{"ごはんを", "食べる"}, CorrectOrder: []int{0, 1}},
+		Meaning:   &model.QuizChoice{Options: []string{"밥을 먹다", "물을 마시다"}, Answer: "밥을 먹다"},
+		Listening: &model.QuizChoice{Options: []string{"ごはんを食べる", "みずを飲む"}, Answer: "ごはんを食べる"},
+		Dictation: &model.QuizDictation{Answer: "ごはんを食べる"},
+		Reading:   &model.QuizReading{Items: []model.QuizReadingItem{{Word: "食べる", Reading: "たべる"}}},
+	}
+}
+
+// shownOrder 사용자에게 섞여 보인 조각 기준의 정답 순서
+func shownOrder(quiz *model.Quiz) []int {
+	perm := pkg.QuizPermutation(testQuizSeed, testSentenceID, pkg.QuizTypeOrdering, len(quiz.Ordering.Fragments))
+	shown := make([]int, len(perm))
+	for i, idx := range perm {
+		shown[idx] = i
+	}
+	order := make([]int, len(quiz.Ordering.CorrectOrder))
+	for i, idx := range quiz.Ordering.CorrectOrder {
+		order[i] = shown[idx]
+	}
+	return order
+}
+
+func TestSubmitQuizCompleteness(t *testing.T) {
+	quiz := fullQuiz()
+	legacyAnswers := map[pkg.QuizType]model.QuizAnswer{
+		pkg.QuizTypeFillBlank: {Text: "ごはん"},
+		pkg.QuizTypeOrdering:  {Order: shownOrder(quiz)},
+	}
+	allAnswers := map[pkg.QuizType]model.QuizAnswer{
+		pkg.QuizTypeFillBlank: {Text: "ごはん"},
+		pkg.QuizTypeOrdering:  {Order: shownOrder(quiz)},
+		pkg.QuizTypeMeaning:   {Text: "밥을 먹다"},
+		pkg.QuizTypeListening: {Text: "ごはんを食べる"},
+		pkg.QuizTypeDictation: {Text: "ごはんを食べる"},
+		pkg.QuizTypeReading:   {Texts: []string{"たべる"}},
+	}
+
+	tests := []struct {
+		name          string
+		answers       map[pkg.QuizType]model.QuizAnswer
+		legacy        bool
+		wantComplete  bool
+		wantMemorized bool
+		wantGrades    []pkg.ReviewGrade
+	}{
+		{
+			name:          "legacy fields only",
+			answers:       legacyAnswers,
+			legacy:        true,
+			wantComplete:  true,
+			wantMemorized: true,
+			wantGrades:    []pkg.ReviewGrade{pkg.ReviewGood},
+		},
+		{
+			name: "legacy fields with a wrong answer",
+			answers: map[pkg.QuizType]model.QuizAnswer{
+				pkg.QuizTypeFillBlank: {Text: "みず"},
+				pkg.QuizTypeOrdering:  legacyAnswers[pkg.QuizTypeOrdering],
+			},
+			legacy:       true,
+			wantComplete: true,
+			wantGrades:   []pkg.ReviewGrade{pkg.ReviewHard},
+		},
+		{
+			name:         "legacy fill blank without ordering",
+			answers:      map[pkg.QuizType]model.QuizAnswer{pkg.QuizTypeFillBlank: {Text: "ごはん"}},
+			legacy:       true,
+			wantComplete: false,
+		},
+		{
+			name:         "same types through answers are partial",
+			answers:      legacyAnswers,
+			wantComplete: false,
+		},
+		{
+			name:          "all types through answers",
+			answers:       allAnswers,
+			wantComplete:  true,
+			wantMemorized: true,
+			wantGrades:    []pkg.ReviewGrade{pkg.ReviewGood},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			learningRepo := &fakeLearningRepo{}
+			attemptRepo := &fakeAttemptRepo{}
+			reviews := &fakeReviews{}
+			s := NewService(learningRepo, &fakeSentenceRepo{detail: &model.SentenceDetail{SentenceID: testSentenceID, Quiz: quiz}},
+				fakeUserRepo{}, attemptRepo, reviews)
+
+			result, err := s.SubmitQuiz(testUserID, &SubmitQuizInput{SentenceID: testSentenceID, Answers: tt.answers, Legacy: tt.legacy})
+			if err != nil {
+				t.Fatalf("SubmitQuiz() error = %v", err)
+			}
+
+			if result.Complete != tt.wantComplete {
+				t.Errorf("Complete = %v, want %v", result.Complete, tt.wantComplete)
+			}
+			if result.Memorized != tt.wantMemorized {
+				t.Errorf("Memorized = %v, want %v", result.Memorized, tt.wantMemorized)
+			}
+			if got := attemptRepo.attempts[0].Complete; got != tt.wantComplete {
+				t.Errorf("attempt.Complete = %v, want %v", got, tt.wantComplete)
+			}
+			if len(reviews.grades) != len(tt.wantGrades) {
+				t.Fatalf("review grades = %v, want %v", reviews.grades, tt.wantGrades)
+			}
+			for i := range tt.wantGrades {
+				if reviews.grades[i] != tt.wantGrades[i] {
+					t.Errorf("review grades = %v, want %v", reviews.grades, tt.wantGrades)
+				}
+			}
+			if !tt.wantComplete && learningRepo.progress != nil {
+				t.Errorf("partial submission created progress: %+v", learningRepo.progress)
+			}
+		})
+	}
+}
@@ -0,0 +1,9 @@
+package review
+
+import "github.com/jptaku/server/internal/model"
+
+// DueReviewsResponse 오늘 복습할 문장 목록
+type DueReviewsResponse struct {
+	Total   int64                  `json:"total"` // 오늘 복습할 차례가 된 전체 문장 수
+	Reviews []model.SentenceReview `json:"reviews"`
+}
@@ -0,0 +1,29 @@
+package review
+
+import (
+	"time"
+
+	"github.com/jptaku/server/internal/model"
+	"github.com/jptaku/server/internal/pkg"
+)
+
+// ReviewRepository 복습 일정 저장소 인터페이스
+type ReviewRepository interface {
+	FindByUserAndSentence(userID, sentenceID uint) (*model.SentenceReview, error)
+	Save(review *model.SentenceReview) error
+	ListDue(userID uint, before time.Time, limit int) ([]model.SentenceReview, error)
+	CountDue(userID uint, before time.Time) (int64, error)
+}
+
+// UserRepository 사용자 저장소 인터페이스
+type UserRepository interface {
+	GetTimezone(userID uint) (string, error)
+}
+
+// Provider 서비스 인터페이스 (외부에서 사용)
+type Provider interface {
+	GetDue(userID uint, limit int) (*DueReviewsResponse, error)
+	Grade(userID, sentenceID uint, grade pkg.ReviewGrade) (*model.SentenceReview, error)
+	Start(userID, sentenceID uint) (*model.SentenceReview, error)
+	RecordQuizResult(userID, sentenceID uint, grade pkg.ReviewGrade) (*model.SentenceReview, error)
+}
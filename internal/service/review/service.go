@@ -0,0 +1,140 @@
+package review
+
+import (
+	"github.com/jptaku/server/internal/model"
+	"github.com/jptaku/server/internal/pkg"
+	"github.com/jptaku/server/internal/repository"
+)
+
+// Service 복습(간격 반복) 서비스
+type Service struct {
+	reviewRepo ReviewRepository
+	userRepo   UserRepository
+	calendar   *pkg.Calendar
+}
+
+// 컴파일 타임 인터페이스 검증
+var _ Provider = (*Service)(nil)
+
+// NewService 서비스 생성자
+func NewService(reviewRepo ReviewRepository, userRepo UserRepository, calendar *pkg.Calendar) *Service {
+	return &Service{
+		reviewRepo: reviewRepo,
+		userRepo:   userRepo,
+		calendar:   calendar,
+	}
+}
+
+// GetDue 오늘(사용자 타임존 기준) 안에 복습할 차례가 된 문장 조회 (오래 밀린 순)
+func (s *Service) GetDue(userID uint, limit int) (*DueReviewsResponse, error) {
+	timezone, _ := s.userRepo.GetTimezone(userID)
+	_, endOfToday := s.calendar.DayRange(s.calendar.Today(timezone), timezone)
+
+	total, err := s.reviewRepo.CountDue(userID, endOfToday)
+	if err != nil {
+		return nil, err
+	}
+	reviews, err := s.reviewRepo.ListDue(userID, endOfToday, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DueReviewsResponse{Total: total, Reviews: reviews}, nil
+}
+
+// Grade 복습 평가 반영 (복습 일정이 있는 문장만, 차례 전이면 연습으로 기록)
+func (s *Service) Grade(userID, sentenceID uint, grade pkg.ReviewGrade) (*model.SentenceReview, error) {
+	review, err := s.reviewRepo.FindByUserAndSentence(userID, sentenceID)
+	if err != nil {
+		if repository.IsNotFound(err) {
+			return nil, pkg.ErrNotFound
+		}
+		return nil, err
+	}
+	return s.apply(review, grade)
+}
+
+// Start 암기 완료한 문장의 복습 일정 시작 (이미 있으면 그대로 반환)
+func (s *Service) Start(userID, sentenceID uint) (*model.SentenceReview, error) {
+	review, err := s.reviewRepo.FindByUserAndSentence(userID, sentenceID)
+	if err == nil {
+		return review, nil
+	}
+	if !repository.IsNotFound(err) {
+		return nil, err
+	}
+	return s.apply(newReview(userID, sentenceID), pkg.ReviewGood)
+}
+
+// RecordQuizResult 퀴즈 결과를 복습 평가로 반영
+// 아직 복습 일정이 없는 문장은 퀴즈를 모두 맞혀 암기를 완료했을 때(ReviewGood 이상) 일정을 시작합니다.
+func (s *Service) RecordQuizResult(userID, sentenceID uint, grade pkg.ReviewGrade) (*model.SentenceReview, error) {
+	review, err := s.reviewRepo.FindByUserAndSentence(userID, sentenceID)
+	if err != nil {
+		if !repository.IsNotFound(err) {
+			return nil, err
+		}
+		if grade < pkg.ReviewGood {
+			return nil, nil
+		}
+		review = newReview(userID, sentenceID)
+	}
+	return s.apply(review, grade)
+}
+
+// apply SM-2로 다음 복습 일정을 계산해 저장
+// 이미 일정이 있는 문장은 복습 차례(사용자 타임존 기준 오늘 안)가 되었을 때만 일정을 진행하고,
+// 그 전에 다시 푼 것은 연습 횟수만 늘립니다. 같은 날 여러 번 풀어도 간격이 계속 늘어나지 않습니다.
+func (s *Service) apply(review *model.SentenceReview, grade pkg.ReviewGrade) (*model.SentenceReview, error) {
+	if review.ID != 0 && !s.isDue(review) {
+		return s.practice(review)
+	}
+
+	next := pkg.SRSState{
+		EaseFactor:   review.EaseFactor,
+		IntervalDays: review.IntervalDays,
+		Repetitions:  review.Repetitions,
+		Lapses:       review.Lapses,
+	}.Next(grade)
+
+	now := s.calendar.Now()
+	review.EaseFactor = next.EaseFactor
+	review.IntervalDays = next.IntervalDays
+	review.Repetitions = next.Repetitions
+	review.Lapses = next.Lapses
+	review.DueAt = next.DueAt(now)
+	review.LastReviewedAt = &now
+
+	if err := s.reviewRepo.Save(review); err != nil {
+		return nil, err
+	}
+	return review, nil
+}
+
+// isDue 복습 차례인지 (GetDue와 같은 기준: 오늘 끝 이전에 예정)
+func (s *Service) isDue(review *model.SentenceReview) bool {
+	timezone, _ := s.userRepo.GetTimezone(review.UserID)
+	_, endOfToday := s.calendar.DayRange(s.calendar.Today(timezone), timezone)
+	return review.DueAt.Before(endOfToday)
+}
+
+// practice 복습 차례 전의 풀이를 연습으로 기록 (일정은 그대로)
+func (s *Service) practice(review *model.SentenceReview) (*model.SentenceReview, error) {
+	now := s.calendar.Now()
+	review.PracticeCount++
+	review.LastPracticedAt = &now
+
+	if err := s.reviewRepo.Save(review); err != nil {
+		return nil, err
+	}
+	return review, nil
+}
+
+func newReview(userID, sentenceID uint) *model.SentenceReview {
+	state := pkg.NewSRSState()
+	return &model.SentenceReview{
+		UserID:     userID,
+		SentenceID: sentenceID,
+		EaseFactor: state.EaseFactor,
+	}
+}
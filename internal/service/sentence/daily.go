@@ -1,36 +1,89 @@
 package sentence
 
 import (
+	"context"
 	"fmt"
+	"log"
 	"time"
 
 	"github.com/jptaku/server/internal/model"
+	"github.com/jptaku/server/internal/pkg"
 )
 
+// dailySetSize 오늘의 문장 수
+const dailySetSize = 5
+
+// recentDays 추천 시 SubCategory 중복을 피할 최근 기간 (일)
+const recentDays = 3
+
 // GetTodaySentences 오늘의 5문장 조회 (없으면 생성)
+// 오늘은 사용자 타임존 기준입니다. 응답 캐시가 설정되어 있으면 캐시된 응답을 사용합니다.
 func (s *Service) GetTodaySentences(userID uint) (*DailySentencesResponse, error) {
-	today := time.Now().Truncate(24 * time.Hour)
-	return s.getSentencesByDate(userID, today)
+	today := s.today(userID)
+	if s.todayCache == nil {
+		return s.getSentencesByDate(userID, today, today)
+	}
+
+	var response DailySentencesResponse
+	err := s.todayCache.Fetch(context.Background(), todayCacheKey(userID, today), s.todayCacheTTL, &response,
+		func() (any, error) {
+			return s.getSentencesByDate(userID, today, today)
+		})
+	if err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// InvalidateToday 오늘의 문장 응답 캐시 무효화 (학습 상태/즐겨찾기 변경 시)
+func (s *Service) InvalidateToday(userID uint) {
+	if s.todayCache == nil {
+		return
+	}
+	key := todayCacheKey(userID, s.today(userID))
+	if err := s.todayCache.Delete(context.Background(), key); err != nil {
+		log.Printf("Failed to invalidate today cache: user=%d err=%v", userID, err)
+	}
+}
+
+// todayCacheKey 오늘의 문장 응답 캐시 키 (sentence:today:{userID}:{date})
+func todayCacheKey(userID uint, date time.Time) string {
+	return fmt.Sprintf("sentence:today:%d:%s", userID, date.Format(pkg.DateLayout))
 }
 
 // GetHistorySentences 지난 학습 문장 조회 (오늘 제외)
 func (s *Service) GetHistorySentences(userID uint, page, perPage int) (*HistorySentencesResponse, error) {
-	dailySets, total, err := s.sentenceRepo.GetPastDailySets(userID, page, perPage)
+	dailySets, total, err := s.sentenceRepo.GetPastDailySets(userID, s.today(userID), page, perPage)
+	if err != nil {
+		return nil, err
+	}
+
+	// 페이지 안 모든 날짜의 문장을 한 번에 조회
+	var allIDs []uint
+	for _, dailySet := range dailySets {
+		allIDs = append(allIDs, dailySet.SentenceIDs...)
+	}
+	sentences, err := s.sentenceRepo.FindByIDs(allIDs)
 	if err != nil {
 		return nil, err
 	}
+	byID := make(map[uint]SentenceWithDetail, len(sentences))
+	for _, swd := range s.buildSentencesWithDetail(userID, sentences) {
+		byID[swd.ID] = swd
+	}
 
 	history := make([]HistoryItem, 0, len(dailySets))
 	for _, dailySet := range dailySets {
-		sentences, err := s.sentenceRepo.FindByIDs(dailySet.SentenceIDs)
-		if err != nil {
-			continue
+		sentencesWithDetail := make([]SentenceWithDetail, 0, len(dailySet.SentenceIDs))
+		for _, id := range dailySet.SentenceIDs {
+			if swd, ok := byID[id]; ok {
+				sentencesWithDetail = append(sentencesWithDetail, swd)
+			}
 		}
 
-		sentencesWithDetail := s.buildSentencesWithDetail(userID, sentences)
 		history = append(history, HistoryItem{
-			Date:      dailySet.Date.Format("2006-01-02"),
-			Sentences: sentencesWithDetail,
+			Date:      dailySet.Date.Format(pkg.DateLayout),
+			Sentences: markReviews(sentencesWithDetail, dailySet.ReviewIDs),
 		})
 	}
 
@@ -49,119 +102,198 @@ func (s *Service) GetHistorySentences(userID uint, page, perPage int) (*HistoryS
 }
 
 // getSentencesByDate 특정 날짜의 문장 조회
-func (s *Service) getSentencesByDate(userID uint, date time.Time) (*DailySentencesResponse, error) {
-	// 해당 날짜의 세트가 있는지 확인
+func (s *Service) getSentencesByDate(userID uint, date, today time.Time) (*DailySentencesResponse, error) {
+	// 해당 날짜의 세트가 있는지 확인 (미리 생성된 세트 포함)
 	dailySet, err := s.sentenceRepo.GetDailySet(userID, date)
-	if err == nil && dailySet != nil {
-		sentences, err := s.sentenceRepo.FindByIDs(dailySet.SentenceIDs)
+	if err != nil || dailySet == nil {
+		// 오늘이 아니면 생성하지 않음
+		if !date.Equal(today) {
+			return nil, fmt.Errorf("해당 날짜의 문장이 없습니다")
+		}
+
+		// 오늘 세트가 없으면 새로 생성
+		dailySet, err = s.createDailySet(userID, date)
 		if err != nil {
 			return nil, err
 		}
+	}
 
-		sentencesWithDetail := s.buildSentencesWithDetail(userID, sentences)
-		return &DailySentencesResponse{
-			Date:      date.Format("2006-01-02"),
-			Sentences: sentencesWithDetail,
-		}, nil
+	sentences, err := s.sentenceRepo.FindByIDs(dailySet.SentenceIDs)
+	if err != nil {
+		return nil, err
 	}
 
-	// 오늘이 아니면 생성하지 않음
-	today := time.Now().Truncate(24 * time.Hour)
-	if !date.Equal(today) {
-		return nil, fmt.Errorf("해당 날짜의 문장이 없습니다")
+	return &DailySentencesResponse{
+		Date:      date.Format(pkg.DateLayout),
+		Sentences: markReviews(s.buildSentencesWithDetail(userID, sentences), dailySet.ReviewIDs),
+	}, nil
+}
+
+// createDailySet 해당 날짜의 문장 세트 생성
+// 같은 날짜의 세트가 동시에 만들어지면 먼저 저장된 세트를 반환합니다.
+func (s *Service) createDailySet(userID uint, date time.Time) (*model.DailySentenceSet, error) {
+	dailySet, err := s.buildDailySet(userID, date)
+	if err != nil {
+		return nil, err
 	}
 
-	// 오늘 세트가 없으면 새로 생성
-	return s.createDailySet(userID, date)
+	created, err := s.sentenceRepo.CreateDailySet(dailySet)
+	if err != nil {
+		return nil, err
+	}
+	if !created {
+		return s.sentenceRepo.GetDailySet(userID, date)
+	}
+	return dailySet, nil
 }
 
-// createDailySet 오늘의 문장 세트 생성
-func (s *Service) createDailySet(userID uint, date time.Time) (*DailySentencesResponse, error) {
+// buildDailySet 문장 세트 구성
+// 복습할 차례가 된 문장을 먼저 넣고 나머지를 아직 배우지 않은 문장으로 채웁니다.
+func (s *Service) buildDailySet(userID uint, date time.Time) (*model.DailySentenceSet, error) {
 	user, err := s.userRepo.FindByID(userID)
 	if err != nil {
 		return nil, err
 	}
 
-	level := 1
-	var interests []int
+	input := &RecommendInput{Level: 1, Seed: DailySeed(userID, date)}
 	if user.Onboarding != nil {
-		level = user.Onboarding.Level
-		interests = user.Onboarding.Interests
+		input.Level = user.Onboarding.Level
+		input.Interests = user.Onboarding.Interests
+		input.Purposes = user.Onboarding.Purposes
 	}
 
-	// 이미 학습한 문장 ID 조회
-	learnedIDs, err := s.sentenceRepo.GetUserLearnedSentenceIDs(userID)
+	reviews, err := s.dueReviews(user, date)
 	if err != nil {
-		learnedIDs = []uint{}
+		return nil, err
 	}
 
-	// 미리 생성된 문장 pool에서 조건에 맞는 5개 가져오기
-	sentences, err := s.sentenceRepo.FindRandom(level, interests, 5, learnedIDs)
-	if err != nil {
-		return nil, fmt.Errorf("문장 조회 실패: %w", err)
+	// 이미 학습한 문장과 최근 세트의 SubCategory 조회
+	if input.ExcludeIDs, err = s.sentenceRepo.GetUserLearnedSentenceIDs(userID); err != nil {
+		return nil, err
+	}
+	if input.RecentSubCategories, err = s.sentenceRepo.GetRecentSubCategories(userID, date.AddDate(0, 0, -recentDays)); err != nil {
+		return nil, err
+	}
+
+	// 미리 생성된 문장 pool에서 새 문장 추천
+	var sentences []model.Sentence
+	if input.Count = dailySetSize - len(reviews); input.Count > 0 {
+		sentences, err = s.recommender.Recommend(input)
+		if err != nil {
+			return nil, fmt.Errorf("문장 조회 실패: %w", err)
+		}
 	}
+	sentences = append(sentences, reviews...)
 
 	if len(sentences) == 0 {
 		return nil, fmt.Errorf("조건에 맞는 문장이 없습니다. 문장 pool이 비어있거나 모든 문장을 학습했습니다")
 	}
 
-	// 상세 정보 조회 및 ID 수집
-	sentencesWithDetail := make([]SentenceWithDetail, 0, len(sentences))
 	sentenceIDs := make([]uint, 0, len(sentences))
-
 	for _, sentence := range sentences {
-		swd := s.buildSentenceWithDetail(userID, sentence)
-		sentencesWithDetail = append(sentencesWithDetail, swd)
 		sentenceIDs = append(sentenceIDs, sentence.ID)
 	}
+	reviewIDs := make([]uint, 0, len(reviews))
+	for _, sentence := range reviews {
+		reviewIDs = append(reviewIDs, sentence.ID)
+	}
 
-	// DailySentenceSet 저장
-	dailySet := &model.DailySentenceSet{
+	return &model.DailySentenceSet{
 		UserID:      userID,
 		Date:        date,
 		SentenceIDs: sentenceIDs,
+		ReviewIDs:   reviewIDs,
+	}, nil
+}
+
+// dueReviews 해당 날짜가 끝나기 전(사용자 타임존 기준)에 복습할 차례가 된 문장 (최대 reviewsPerDay개)
+func (s *Service) dueReviews(user *model.User, date time.Time) ([]model.Sentence, error) {
+	if s.reviewRepo == nil || s.reviewsPerDay <= 0 {
+		return nil, nil
+	}
+
+	var timezone string
+	if user.Settings != nil {
+		timezone = user.Settings.Timezone
 	}
+	_, endOfDay := s.calendar.DayRange(date, timezone)
 
-	if err := s.sentenceRepo.CreateDailySet(dailySet); err != nil {
+	limit := s.reviewsPerDay
+	if limit > dailySetSize {
+		limit = dailySetSize
+	}
+	ids, err := s.reviewRepo.FindDueSentenceIDs(user.ID, endOfDay, limit)
+	if err != nil || len(ids) == 0 {
 		return nil, err
 	}
+	return s.sentenceRepo.FindByIDs(ids)
+}
 
-	return &DailySentencesResponse{
-		Date:      date.Format("2006-01-02"),
-		Sentences: sentencesWithDetail,
-	}, nil
+// markReviews 복습으로 섞인 문장 표시
+func markReviews(sentences []SentenceWithDetail, reviewIDs []uint) []SentenceWithDetail {
+	for i := range sentences {
+		for _, id := range reviewIDs {
+			if sentences[i].ID == id {
+				sentences[i].Review = true
+				break
+			}
+		}
+	}
+	return sentences
 }
 
 // buildSentencesWithDetail 문장 목록에 상세 정보 추가
+// 상세 정보, 학습 상태, 즐겨찾기 여부를 문장 수와 관계없이 각각 한 번의 쿼리로 가져옵니다.
 func (s *Service) buildSentencesWithDetail(userID uint, sentences []model.Sentence) []SentenceWithDetail {
-	result := make([]SentenceWithDetail, 0, len(sentences))
-	for _, sentence := range sentences {
-		swd := s.buildSentenceWithDetail(userID, sentence)
-		result = append(result, swd)
+	ids := make([]uint, len(sentences))
+	for i, sentence := range sentences {
+		ids[i] = sentence.ID
 	}
-	return result
-}
 
-// buildSentenceWithDetail 단일 문장에 상세 정보 추가
-func (s *Service) buildSentenceWithDetail(userID uint, sentence model.Sentence) SentenceWithDetail {
-	swd := SentenceWithDetail{
-		Sentence: sentence,
+	details := make(map[uint]model.SentenceDetail, len(ids))
+	if found, err := s.sentenceRepo.GetDetails(ids); err == nil {
+		for _, detail := range found {
+			details[detail.SentenceID] = detail
+		}
 	}
 
-	// 상세 정보 조회
-	if detail, _ := s.sentenceRepo.GetDetail(sentence.ID); detail != nil {
-		swd.Words = detail.Words
-		swd.Grammar = detail.Grammar
-		swd.Examples = detail.Examples
-		swd.Quiz = detail.Quiz
+	memorized := make(map[uint]bool, len(ids))
+	if s.learningRepo != nil {
+		if progresses, err := s.learningRepo.FindLatestByUserAndSentences(userID, ids); err == nil {
+			for _, progress := range progresses {
+				memorized[progress.SentenceID] = progress.Memorized
+			}
+		}
 	}
 
-	// 학습 상태 조회
-	if s.learningRepo != nil {
-		if progress, _ := s.learningRepo.FindByUserAndSentence(userID, sentence.ID); progress != nil {
-			swd.Memorized = progress.Memorized
+	// 퀴즈 보기 순서 시드 (조회 실패 시 퀴즈는 내려주지 않음, 채점 순서와 어긋나지 않도록)
+	quizSeed, seedErr := s.userRepo.GetQuizSeed(userID)
+
+	bookmarked := make(map[uint]bool, len(ids))
+	if s.bookmarkRepo != nil {
+		if bookmarkedIDs, err := s.bookmarkRepo.FindBookmarkedSentenceIDs(userID, ids); err == nil {
+			for _, id := range bookmarkedIDs {
+				bookmarked[id] = true
+			}
 		}
 	}
 
-	return swd
+	result := make([]SentenceWithDetail, 0, len(sentences))
+	for _, sentence := range sentences {
+		swd := SentenceWithDetail{
+			Sentence:   sentence,
+			Memorized:  memorized[sentence.ID],
+			Bookmarked: bookmarked[sentence.ID],
+		}
+		if detail, ok := details[sentence.ID]; ok {
+			swd.Words = detail.Words
+			swd.Grammar = detail.Grammar
+			swd.Examples = detail.Examples
+			if seedErr == nil {
+				swd.Quiz = newQuizView(detail.Quiz, quizSeed, &sentence)
+			}
+		}
+		result = append(result, swd)
+	}
+	return result
 }
@@ -5,11 +5,55 @@ import "github.com/jptaku/server/internal/model"
 // SentenceWithDetail 문장 + 상세 정보
 type SentenceWithDetail struct {
 	model.Sentence
-	Words     []model.Word `json:"words"`
-	Grammar   []string     `json:"grammar"`
-	Examples  []string     `json:"examples"`
-	Quiz      *model.Quiz  `json:"quiz"`
-	Memorized bool         `json:"memorized"`
+	Words      []model.Word `json:"words"`
+	Grammar    []string     `json:"grammar"`
+	Examples   []string     `json:"examples"`
+	Quiz       *QuizView    `json:"quiz"` // 정답을 뺀 퀴즈 (채점은 learning.Service.SubmitQuiz에서만)
+	Memorized  bool         `json:"memorized"`
+	Review     bool         `json:"review"`     // 복습할 차례가 되어 섞인 문장
+	Bookmarked bool         `json:"bookmarked"` // 즐겨찾기 여부
+}
+
+// QuizView 클라이언트에 보여줄 퀴즈 (정답 제외, 사용자별로 섞인 순서)
+type QuizView struct {
+	FillBlank *FillBlankView `json:"fill_blank,omitempty"`
+	Ordering  *OrderingView  `json:"ordering,omitempty"`
+	Meaning   *ChoiceView    `json:"meaning,omitempty"`
+	Listening *ListeningView `json:"listening,omitempty"`
+	Dictation *DictationView `json:"dictation,omitempty"`
+	Reading   *ReadingView   `json:"reading,omitempty"`
+}
+
+// FillBlankView 빈칸 채우기 (보기 순서는 사용자별로 섞임)
+type FillBlankView struct {
+	QuestionJP string   `json:"question_jp"`
+	Options    []string `json:"options"`
+}
+
+// OrderingView 문장 배열하기 (답안은 이 Fragments의 인덱스로 제출)
+type OrderingView struct {
+	Fragments []string `json:"fragments"`
+}
+
+// ChoiceView 한국어 뜻 고르기 (보기 순서는 사용자별로 섞임)
+type ChoiceView struct {
+	Options []string `json:"options"`
+}
+
+// ListeningView 음성을 듣고 문장 고르기
+type ListeningView struct {
+	AudioURL string   `json:"audio_url"`
+	Options  []string `json:"options"`
+}
+
+// DictationView 음성을 듣고 받아쓰기
+type DictationView struct {
+	AudioURL string `json:"audio_url"`
+}
+
+// ReadingView 단어 읽기 (답안은 Words 순서대로 가나로 제출)
+type ReadingView struct {
+	Words []string `json:"words"`
 }
 
 // DailySentencesResponse 오늘의 5문장 응답
@@ -32,3 +76,19 @@ type HistorySentencesResponse struct {
 	Total      int64         `json:"total"`
 	TotalPages int           `json:"total_pages"`
 }
+
+// 검색 범위
+const (
+	SearchScopeAll  = "all"  // 전체 문장 pool
+	SearchScopeMine = "mine" // 내 데일리 세트에 나온 문장만
+)
+
+// SearchInput 문장 검색 입력
+type SearchInput struct {
+	Query       string
+	Scope       string // SearchScopeAll, SearchScopeMine
+	Level       *int
+	SubCategory *int
+	Page        int
+	PerPage     int
+}
@@ -1,6 +1,7 @@
 package sentence
 
 import (
+	"context"
 	"time"
 
 	"github.com/jptaku/server/internal/model"
@@ -8,28 +9,66 @@ import (
 
 // SentenceRepository 문장 저장소 인터페이스
 type SentenceRepository interface {
+	CandidateRepository
 	GetDailySet(userID uint, date time.Time) (*model.DailySentenceSet, error)
-	GetPastDailySets(userID uint, page, perPage int) ([]model.DailySentenceSet, int64, error)
+	GetPastDailySets(userID uint, before time.Time, page, perPage int) ([]model.DailySentenceSet, int64, error)
 	FindByIDs(ids []uint) ([]model.Sentence, error)
-	FindRandom(level int, interests []int, count int, excludeIDs []uint) ([]model.Sentence, error)
-	GetDetail(sentenceID uint) (*model.SentenceDetail, error)
+	GetDetails(sentenceIDs []uint) ([]model.SentenceDetail, error)
 	GetUserLearnedSentenceIDs(userID uint) ([]uint, error)
-	CreateDailySet(dailySet *model.DailySentenceSet) error
+	GetRecentSubCategories(userID uint, since time.Time) ([]int, error)
+	CreateDailySet(dailySet *model.DailySentenceSet) (bool, error)
+	Search(filter *model.SentenceSearchFilter, page, perPage int) ([]model.Sentence, int64, error)
+}
+
+// CandidateRepository 추천 후보 문장 저장소 인터페이스
+type CandidateRepository interface {
+	MaxSentenceID() (uint, error)
+	FindCandidates(maxLevel, subCategory int, excludeIDs []uint, startID uint, limit int) ([]model.Sentence, error)
+}
+
+// Recommender 오늘의 새 문장 추천 인터페이스
+type Recommender interface {
+	Recommend(input *RecommendInput) ([]model.Sentence, error)
 }
 
 // UserRepository 사용자 저장소 인터페이스
 type UserRepository interface {
 	FindByID(id uint) (*model.User, error)
+	GetTimezone(userID uint) (string, error)
+	GetQuizSeed(userID uint) (int64, error)
+	FindActiveUserIDs(since time.Time, afterID uint, limit int) ([]uint, error)
+}
+
+// ReviewRepository 복습 일정 저장소 인터페이스
+type ReviewRepository interface {
+	FindDueSentenceIDs(userID uint, before time.Time, limit int) ([]uint, error)
+}
+
+// BookmarkRepository 즐겨찾기 저장소 인터페이스
+type BookmarkRepository interface {
+	FindBookmarkedSentenceIDs(userID uint, sentenceIDs []uint) ([]uint, error)
 }
 
 // LearningRepository 학습 저장소 인터페이스
 type LearningRepository interface {
-	FindByUserAndSentence(userID, sentenceID uint) (*model.LearningProgress, error)
+	FindLatestByUserAndSentences(userID uint, sentenceIDs []uint) ([]model.LearningProgress, error)
+}
+
+// ResponseCache 응답 캐시 인터페이스 (cache.ReadThrough)
+type ResponseCache interface {
+	Fetch(ctx context.Context, key string, ttl time.Duration, dest any, load func() (any, error)) error
+	Delete(ctx context.Context, keys ...string) error
 }
 
 // Provider 서비스 인터페이스 (외부에서 사용)
 type Provider interface {
 	GetTodaySentences(userID uint) (*DailySentencesResponse, error)
 	GetHistorySentences(userID uint, page, perPage int) (*HistorySentencesResponse, error)
+	SearchSentences(userID uint, input *SearchInput) ([]SentenceWithDetail, int64, error)
+	PregenerateDailySets(ctx context.Context, activeSince time.Time) (int, error)
 	SetLearningRepo(learningRepo LearningRepository)
+	SetReviewRepo(reviewRepo ReviewRepository, perDay int)
+	SetBookmarkRepo(bookmarkRepo BookmarkRepository)
+	SetTodayCache(todayCache ResponseCache, ttl time.Duration)
+	InvalidateToday(userID uint)
 }
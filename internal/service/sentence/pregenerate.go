@@ -0,0 +1,46 @@
+package sentence
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/jptaku/server/internal/pkg"
+)
+
+// pregenerateBatchSize 미리 생성 작업에서 한 번에 조회할 사용자 수
+const pregenerateBatchSize = 500
+
+// PregenerateDailySets 최근 활동한 사용자의 내일(사용자 타임존 기준) 문장 세트 미리 생성 (백그라운드 작업)
+// 이미 세트가 있는 사용자는 건너뛰므로 여러 번 실행해도 안전합니다.
+func (s *Service) PregenerateDailySets(ctx context.Context, activeSince time.Time) (int, error) {
+	created := 0
+	var afterID uint
+	for {
+		userIDs, err := s.userRepo.FindActiveUserIDs(activeSince, afterID, pregenerateBatchSize)
+		if err != nil {
+			return created, err
+		}
+
+		for _, userID := range userIDs {
+			if err := ctx.Err(); err != nil {
+				return created, err
+			}
+
+			tomorrow := s.today(userID).AddDate(0, 0, 1)
+			if existing, err := s.sentenceRepo.GetDailySet(userID, tomorrow); err == nil && existing != nil {
+				continue
+			}
+			if _, err := s.createDailySet(userID, tomorrow); err != nil {
+				log.Printf("Failed to pregenerate daily set: user=%d date=%s err=%v", userID, tomorrow.Format(pkg.DateLayout), err)
+				continue
+			}
+			created++
+		}
+
+		if len(userIDs) < pregenerateBatchSize {
+			return created, nil
+		}
+		afterID = userIDs[len(userIDs)-1]
+	}
+}
@@ -0,0 +1,65 @@
+package sentence
+
+import (
+	"github.com/jptaku/server/internal/model"
+	"github.com/jptaku/server/internal/pkg"
+)
+
+// newQuizView 정답을 빼고 보기/조각을 사용자 시드로 섞은 퀴즈
+// 섞는 순서는 pkg.QuizPermutation으로 정해지며, 채점할 때 같은 순서로 되돌립니다.
+// 듣기/받아쓰기는 음성이 있는 문장에만 내려줍니다.
+func newQuizView(quiz *model.Quiz, seed int64, sentence *model.Sentence) *QuizView {
+	if quiz == nil {
+		return nil
+	}
+
+	shuffled := func(quizType pkg.QuizType, items []string) []string {
+		return permute(items, pkg.QuizPermutation(seed, sentence.ID, quizType, len(items)))
+	}
+
+	view := &QuizView{}
+	if quiz.FillBlank != nil {
+		view.FillBlank = &FillBlankView{
+			QuestionJP: quiz.FillBlank.QuestionJP,
+			Options:    shuffled(pkg.QuizTypeFillBlank, quiz.FillBlank.Options),
+		}
+	}
+	if quiz.Ordering != nil {
+		view.Ordering = &OrderingView{
+			Fragments: shuffled(pkg.QuizTypeOrdering, quiz.Ordering.Fragments),
+		}
+	}
+	if quiz.Meaning != nil {
+		view.Meaning = &ChoiceView{
+			Options: shuffled(pkg.QuizTypeMeaning, quiz.Meaning.Options),
+		}
+	}
+	if sentence.AudioURL != "" {
+		if quiz.Listening != nil {
+			view.Listening = &ListeningView{
+				AudioURL: sentence.AudioURL,
+				Options:  shuffled(pkg.QuizTypeListening, quiz.Listening.Options),
+			}
+		}
+		if quiz.Dictation != nil {
+			view.Dictation = &DictationView{AudioURL: sentence.AudioURL}
+		}
+	}
+	if quiz.Reading != nil {
+		words := make([]string, len(quiz.Reading.Items))
+		for i, item := range quiz.Reading.Items {
+			words[i] = item.Word
+		}
+		view.Reading = &ReadingView{Words: words}
+	}
+	return view
+}
+
+// permute perm 순서대로 원소 재배치 (i번째 자리에 items[perm[i]])
+func permute(items []string, perm []int) []string {
+	result := make([]string, len(perm))
+	for i, idx := range perm {
+		result[i] = items[idx]
+	}
+	return result
+}
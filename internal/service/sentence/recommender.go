@@ -0,0 +1,224 @@
+package sentence
+
+import (
+	"hash/fnv"
+	"math"
+	"math/rand"
+	"sort"
+	"time"
+
+	"github.com/jptaku/server/internal/model"
+	"github.com/jptaku/server/internal/pkg"
+)
+
+// 추천 가중치
+const (
+	baseAffinity     = 0.2  // 관심사/목적과 무관한 SubCategory
+	interestAffinity = 0.6  // 관심사로 고른 SubCategory 가산
+	purposeAffinity  = 0.4  // 학습 목적과 관련된 SubCategory 가산
+	levelStep        = 0.25 // 사용자 레벨보다 한 단계 쉬울 때마다 감점
+	minLevelWeight   = 0.25
+	recentPenalty    = 0.6 // 최근 세트에 나온 SubCategory, 나온 횟수만큼 곱함
+)
+
+// 후보 문장 수 (SubCategory별)
+const (
+	preferredCandidates = 20
+	otherCandidates     = 5
+)
+
+// maxSentenceLevel 문장 pool의 최고 난이도 (pkg.LevelN3)
+const maxSentenceLevel = 3
+
+// RecommendInput 오늘의 문장 추천 입력
+type RecommendInput struct {
+	Level               int    // 온보딩 레벨
+	Interests           []int  // pkg.SubCategory 값들
+	Purposes            []int  // pkg.Purpose 값들
+	ExcludeIDs          []uint // 이미 배운 문장
+	RecentSubCategories []int  // 최근 세트에 나온 문장의 SubCategory (중복 허용)
+	Count               int
+	Seed                int64 // 같은 시드면 같은 후보에서 같은 문장을 고릅니다
+}
+
+// WeightedRecommender 가중치 기반 오늘의 문장 추천
+// SubCategory마다 무작위 지점부터 ID 순으로 후보를 조금씩 가져온 뒤(ORDER BY RANDOM() 없이 인덱스만 사용),
+// 관심사/목적 적합도, 난이도, 최근 출제 여부로 가중치를 매겨 SubCategory가 겹치지 않도록 뽑습니다.
+type WeightedRecommender struct {
+	repo CandidateRepository
+}
+
+// NewWeightedRecommender WeightedRecommender 생성자
+func NewWeightedRecommender(repo CandidateRepository) *WeightedRecommender {
+	return &WeightedRecommender{repo: repo}
+}
+
+// Recommend 오늘의 새 문장 추천
+func (r *WeightedRecommender) Recommend(input *RecommendInput) ([]model.Sentence, error) {
+	rng := rand.New(rand.NewSource(input.Seed))
+
+	maxID, err := r.repo.MaxSentenceID()
+	if err != nil || maxID == 0 {
+		return nil, err
+	}
+
+	maxLevel := clampLevel(input.Level)
+	preferred := preferredSubCategories(input)
+
+	var candidates []model.Sentence
+	for _, subCategory := range pkg.AllSubCategories {
+		limit := otherCandidates
+		if preferred[int(subCategory)] || len(preferred) == 0 {
+			limit = preferredCandidates
+		}
+
+		startID := uint(rng.Int63n(int64(maxID))) + 1
+		found, err := r.repo.FindCandidates(maxLevel, int(subCategory), input.ExcludeIDs, startID, limit)
+		if err != nil {
+			return nil, err
+		}
+		candidates = append(candidates, found...)
+	}
+
+	return PickSentences(candidates, input, rng), nil
+}
+
+// PickSentences 후보 중 input.Count개를 가중치 비복원 추출
+// 이번 세트에서 덜 고른 SubCategory의 후보만 뽑으므로, 다른 SubCategory 후보가 남아 있는 동안 같은 SubCategory가 반복되지 않습니다.
+// 후보 순서와 rng가 같으면 결과도 같습니다.
+func PickSentences(candidates []model.Sentence, input *RecommendInput, rng *rand.Rand) []model.Sentence {
+	pool := make([]model.Sentence, len(candidates))
+	copy(pool, candidates)
+	sort.Slice(pool, func(i, j int) bool { return pool[i].ID < pool[j].ID })
+
+	weights := make([]float64, len(pool))
+	for i, sentence := range pool {
+		weights[i] = candidateWeight(sentence, input)
+	}
+
+	picked := make([]model.Sentence, 0, input.Count)
+	pickedBySub := make(map[int]int)
+	for len(picked) < input.Count && len(pool) > 0 {
+		fewest := math.MaxInt
+		for _, sentence := range pool {
+			if n := pickedBySub[sentence.SubCategory]; n < fewest {
+				fewest = n
+			}
+		}
+
+		total := 0.0
+		adjusted := make([]float64, len(pool))
+		for i, sentence := range pool {
+			if pickedBySub[sentence.SubCategory] == fewest {
+				adjusted[i] = weights[i]
+			}
+			total += adjusted[i]
+		}
+
+		target := rng.Float64() * total
+		idx := -1
+		for i, w := range adjusted {
+			if w == 0 {
+				continue
+			}
+			idx = i
+			if target < w {
+				break
+			}
+			target -= w
+		}
+
+		picked = append(picked, pool[idx])
+		pickedBySub[pool[idx].SubCategory]++
+		pool = append(pool[:idx], pool[idx+1:]...)
+		weights = append(weights[:idx], weights[idx+1:]...)
+	}
+	return picked
+}
+
+// candidateWeight 문장 하나의 기본 가중치 (관심사/목적 적합도 × 난이도 × 최근 출제 감점)
+func candidateWeight(sentence model.Sentence, input *RecommendInput) float64 {
+	affinity := 1.0
+	if len(input.Interests) > 0 || len(input.Purposes) > 0 {
+		affinity = baseAffinity
+		if containsInt(input.Interests, sentence.SubCategory) {
+			affinity += interestAffinity
+		}
+		for _, p := range input.Purposes {
+			if containsSubCategory(pkg.Purpose(p).SubCategories(), sentence.SubCategory) {
+				affinity += purposeAffinity
+				break
+			}
+		}
+	}
+
+	// 사용자 레벨에 가까울수록 높게, 쉬운 문장은 조금씩 낮게
+	levelWeight := 1 - levelStep*float64(clampLevel(input.Level)-sentence.Level)
+	if levelWeight < minLevelWeight {
+		levelWeight = minLevelWeight
+	}
+
+	recent := 0
+	for _, sub := range input.RecentSubCategories {
+		if sub == sentence.SubCategory {
+			recent++
+		}
+	}
+
+	return affinity * levelWeight * math.Pow(recentPenalty, float64(recent))
+}
+
+// DailySeed 사용자와 날짜로 정해지는 추천 시드 (같은 날 다시 만들어도 같은 결과)
+func DailySeed(userID uint, date time.Time) int64 {
+	h := fnv.New64a()
+	h.Write([]byte(date.Format(pkg.DateLayout)))
+	var buf [8]byte
+	for i := range buf {
+		buf[i] = byte(uint64(userID) >> (8 * i))
+	}
+	h.Write(buf[:])
+	return int64(h.Sum64())
+}
+
+// preferredSubCategories 관심사와 학습 목적으로 고른 SubCategory 집합
+func preferredSubCategories(input *RecommendInput) map[int]bool {
+	preferred := make(map[int]bool)
+	for _, sub := range input.Interests {
+		preferred[sub] = true
+	}
+	for _, p := range input.Purposes {
+		for _, sub := range pkg.Purpose(p).SubCategories() {
+			preferred[int(sub)] = true
+		}
+	}
+	return preferred
+}
+
+// clampLevel 온보딩 레벨(0~5)을 문장 난이도 범위(0~3)로 맞춤
+func clampLevel(level int) int {
+	if level < 0 {
+		return 0
+	}
+	if level > maxSentenceLevel {
+		return maxSentenceLevel
+	}
+	return level
+}
+
+func containsInt(values []int, v int) bool {
+	for _, x := range values {
+		if x == v {
+			return true
+		}
+	}
+	return false
+}
+
+func containsSubCategory(values []pkg.SubCategory, v int) bool {
+	for _, x := range values {
+		if int(x) == v {
+			return true
+		}
+	}
+	return false
+}
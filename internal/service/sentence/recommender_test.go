@@ -0,0 +1,183 @@
+package sentence
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/jptaku/server/internal/model"
+	"github.com/jptaku/server/internal/pkg"
+)
+
+// candidatesFor SubCategory마다 perSub개씩 후보 문장 생성 (ID는 1부터 연속)
+func candidatesFor(subs []int, perSub, level int) []model.Sentence {
+	var candidates []model.Sentence
+	for _, sub := range subs {
+		for i := 0; i < perSub; i++ {
+			candidates = append(candidates, model.Sentence{
+				ID:          uint(len(candidates) + 1),
+				SubCategory: sub,
+				Level:       level,
+			})
+		}
+	}
+	return candidates
+}
+
+func subCategories(n int) []int {
+	subs := make([]int, n)
+	for i := range subs {
+		subs[i] = int(pkg.AllSubCategories[i])
+	}
+	return subs
+}
+
+func pickedIDs(sentences []model.Sentence) []uint {
+	ids := make([]uint, len(sentences))
+	for i, s := range sentences {
+		ids[i] = s.ID
+	}
+	return ids
+}
+
+func TestPickSentencesSameSeed(t *testing.T) {
+	candidates := candidatesFor(subCategories(4), 5, 2)
+	input := &RecommendInput{Level: 2, Count: 3}
+
+	// 후보 순서가 달라도 같은 시드면 같은 문장을 고릅니다.
+	reversed := make([]model.Sentence, len(candidates))
+	for i, s := range candidates {
+		reversed[len(candidates)-1-i] = s
+	}
+
+	for seed := int64(1); seed <= 20; seed++ {
+		first := pickedIDs(PickSentences(candidates, input, rand.New(rand.NewSource(seed))))
+		second := pickedIDs(PickSentences(reversed, input, rand.New(rand.NewSource(seed))))
+		if len(first) != input.Count {
+			t.Fatalf("seed %d: picked %d sentences, want %d", seed, len(first), input.Count)
+		}
+		for i := range first {
+			if first[i] != second[i] {
+				t.Fatalf("seed %d: picks differ: %v vs %v", seed, first, second)
+			}
+		}
+	}
+}
+
+func TestPickSentencesSpreadsSubCategories(t *testing.T) {
+	tests := []struct {
+		name    string
+		subs    int
+		perSub  int
+		count   int
+		wantMax int // 한 SubCategory에서 고를 수 있는 최대 문장 수
+	}{
+		{"more sub-categories than picks", 5, 4, 3, 1},
+		{"as many sub-categories as picks", 3, 4, 3, 1},
+		{"fewer sub-categories than picks", 2, 4, 4, 2},
+		{"single sub-category", 1, 4, 3, 3},
+		{"one candidate per sub-category", 2, 1, 2, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			candidates := candidatesFor(subCategories(tt.subs), tt.perSub, 1)
+			input := &RecommendInput{Level: 1, Count: tt.count}
+
+			for seed := int64(1); seed <= 50; seed++ {
+				picked := PickSentences(candidates, input, rand.New(rand.NewSource(seed)))
+				if len(picked) != tt.count {
+					t.Fatalf("seed %d: picked %d sentences, want %d", seed, len(picked), tt.count)
+				}
+				bySub := make(map[int]int)
+				for _, s := range picked {
+					bySub[s.SubCategory]++
+					if bySub[s.SubCategory] > tt.wantMax {
+						t.Fatalf("seed %d: sub-category %d picked %d times, want at most %d", seed, s.SubCategory, bySub[s.SubCategory], tt.wantMax)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestCandidateWeightRecentPenalty(t *testing.T) {
+	sub := int(pkg.AllSubCategories[0])
+	other := int(pkg.AllSubCategories[1])
+	sentence := model.Sentence{SubCategory: sub, Level: 2}
+
+	tests := []struct {
+		recent []int
+		want   float64
+	}{
+		{nil, 1},
+		{[]int{other}, 1},
+		{[]int{sub}, recentPenalty},
+		{[]int{sub, other, sub}, recentPenalty * recentPenalty},
+		{[]int{sub, sub, sub}, recentPenalty * recentPenalty * recentPenalty},
+	}
+	for _, tt := range tests {
+		input := &RecommendInput{Level: 2, RecentSubCategories: tt.recent}
+		if got := candidateWeight(sentence, input); math.Abs(got-tt.want) > 1e-9 {
+			t.Errorf("candidateWeight(recent=%v) = %v, want %v", tt.recent, got, tt.want)
+		}
+	}
+}
+
+func TestPickSentencesPrefersNotRecent(t *testing.T) {
+	subs := subCategories(2)
+	candidates := candidatesFor(subs, 1, 1)
+	input := &RecommendInput{Level: 1, Count: 1, RecentSubCategories: []int{subs[0], subs[0], subs[0]}}
+
+	recentPicks := 0
+	const runs = 200
+	for seed := int64(1); seed <= runs; seed++ {
+		picked := PickSentences(candidates, input, rand.New(rand.NewSource(seed)))
+		if picked[0].SubCategory == subs[0] {
+			recentPicks++
+		}
+	}
+	// 가중치 비율이 0.216:1이므로 최근 SubCategory는 약 18%만 뽑혀야 합니다.
+	if recentPicks == 0 || recentPicks > runs/3 {
+		t.Errorf("recent sub-category picked %d/%d times, want roughly 18%%", recentPicks, runs)
+	}
+}
+
+func TestCandidateWeightLevelRamp(t *testing.T) {
+	tests := []struct {
+		userLevel     int
+		sentenceLevel int
+		want          float64
+	}{
+		{3, 3, 1},
+		{3, 2, 1 - levelStep},
+		{3, 1, 1 - 2*levelStep},
+		{3, 0, minLevelWeight},
+		{5, 3, 1},             // 온보딩 레벨은 문장 난이도 범위로 맞춤
+		{5, 2, 1 - levelStep}, // 문장 최고 난이도(N3)보다 높아도 N3 기준
+		{0, 0, 1},
+		{-1, 0, 1},
+	}
+	for _, tt := range tests {
+		input := &RecommendInput{Level: tt.userLevel}
+		sentence := model.Sentence{SubCategory: int(pkg.AllSubCategories[0]), Level: tt.sentenceLevel}
+		if got := candidateWeight(sentence, input); math.Abs(got-tt.want) > 1e-9 {
+			t.Errorf("candidateWeight(user=%d, sentence=%d) = %v, want %v", tt.userLevel, tt.sentenceLevel, got, tt.want)
+		}
+	}
+
+	// 레벨이 가까운 문장이 더 자주 뽑힙니다.
+	candidates := []model.Sentence{
+		{ID: 1, SubCategory: int(pkg.AllSubCategories[0]), Level: 3},
+		{ID: 2, SubCategory: int(pkg.AllSubCategories[1]), Level: 1},
+	}
+	input := &RecommendInput{Level: 3, Count: 1}
+	closer := 0
+	for seed := int64(1); seed <= 200; seed++ {
+		if PickSentences(candidates, input, rand.New(rand.NewSource(seed)))[0].ID == 1 {
+			closer++
+		}
+	}
+	if closer <= 100 {
+		t.Errorf("sentence at user level picked %d/200 times, want more than half", closer)
+	}
+}
@@ -0,0 +1,32 @@
+package sentence
+
+import (
+	"github.com/jptaku/server/internal/model"
+	"github.com/jptaku/server/internal/pkg"
+)
+
+// SearchSentences 문장 검색
+// 검색어와 문장 모두 pkg.NormalizeSearchText로 정규화해 비교하므로 반각/전각, 가타카나/히라가나 차이는 무시됩니다.
+// SearchScopeMine이면 사용자 타임존 기준 오늘까지 받은 데일리 세트의 문장만 검색합니다.
+func (s *Service) SearchSentences(userID uint, input *SearchInput) ([]SentenceWithDetail, int64, error) {
+	query := pkg.NormalizeSearchText(input.Query)
+	if query == "" {
+		return nil, 0, pkg.ErrBadRequest
+	}
+
+	filter := &model.SentenceSearchFilter{
+		Query:       query,
+		Level:       input.Level,
+		SubCategory: input.SubCategory,
+	}
+	if input.Scope == SearchScopeMine {
+		filter.AssignedUserID = userID
+		filter.AssignedUntil = s.today(userID)
+	}
+
+	sentences, total, err := s.sentenceRepo.Search(filter, input.Page, input.PerPage)
+	if err != nil {
+		return nil, 0, err
+	}
+	return s.buildSentencesWithDetail(userID, sentences), total, nil
+}
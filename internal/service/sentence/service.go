@@ -1,24 +1,63 @@
 package sentence
 
+import (
+	"time"
+
+	"github.com/jptaku/server/internal/pkg"
+)
+
 // Service 문장 관련 비즈니스 로직 구현체
 type Service struct {
 	sentenceRepo SentenceRepository
 	userRepo     UserRepository
 	learningRepo LearningRepository
+	reviewRepo   ReviewRepository
+	bookmarkRepo BookmarkRepository
+	recommender  Recommender
+	calendar     *pkg.Calendar
+	todayCache   ResponseCache
+
+	reviewsPerDay int           // 오늘의 문장에 섞을 최대 복습 문장 수
+	todayCacheTTL time.Duration // 오늘의 문장 응답 캐시 유지 시간
 }
 
 // 컴파일 타임에 인터페이스 구현 확인
 var _ Provider = (*Service)(nil)
 
 // NewService SentenceService 생성자
-func NewService(sentenceRepo SentenceRepository, userRepo UserRepository) *Service {
+func NewService(sentenceRepo SentenceRepository, userRepo UserRepository, calendar *pkg.Calendar) *Service {
 	return &Service{
 		sentenceRepo: sentenceRepo,
 		userRepo:     userRepo,
+		recommender:  NewWeightedRecommender(sentenceRepo),
+		calendar:     calendar,
 	}
 }
 
+// today 사용자 타임존 기준 오늘 날짜
+func (s *Service) today(userID uint) time.Time {
+	timezone, _ := s.userRepo.GetTimezone(userID)
+	return s.calendar.Today(timezone)
+}
+
 // SetLearningRepo LearningRepository 설정 (순환 의존성 방지)
 func (s *Service) SetLearningRepo(learningRepo LearningRepository) {
 	s.learningRepo = learningRepo
 }
+
+// SetReviewRepo 복습 일정 저장소 설정 (오늘의 문장에 복습할 차례가 된 문장을 최대 perDay개 섞음)
+func (s *Service) SetReviewRepo(reviewRepo ReviewRepository, perDay int) {
+	s.reviewRepo = reviewRepo
+	s.reviewsPerDay = perDay
+}
+
+// SetBookmarkRepo 즐겨찾기 저장소 설정 (문장에 즐겨찾기 여부 표시)
+func (s *Service) SetBookmarkRepo(bookmarkRepo BookmarkRepository) {
+	s.bookmarkRepo = bookmarkRepo
+}
+
+// SetTodayCache 오늘의 문장 응답 캐시 설정 (학습 상태/즐겨찾기가 바뀌면 InvalidateToday로 무효화)
+func (s *Service) SetTodayCache(todayCache ResponseCache, ttl time.Duration) {
+	s.todayCache = todayCache
+	s.todayCacheTTL = ttl
+}
@@ -0,0 +1,152 @@
+package user
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"io"
+	"log"
+	"time"
+)
+
+// purgeBatchSize 한 번의 정리 작업에서 영구 삭제할 최대 사용자 수
+const purgeBatchSize = 100
+
+// DeleteAccount 탈퇴 요청
+// 모든 기기에서 로그아웃되고, 유예 기간이 지나면 모든 데이터가 영구 삭제됩니다.
+// 유예 기간 안에 다시 로그인하면 탈퇴가 취소됩니다.
+func (s *Service) DeleteAccount(ctx context.Context, userID uint) (time.Time, error) {
+	if _, err := s.userRepo.FindByID(userID); err != nil {
+		return time.Time{}, err
+	}
+
+	scheduledAt := time.Now().Add(s.deletionGrace)
+	if err := s.userRepo.ScheduleDeletion(userID, scheduledAt); err != nil {
+		return time.Time{}, err
+	}
+
+	if err := s.sessions.RevokeAllSessions(ctx, userID); err != nil {
+		log.Printf("Failed to revoke sessions of deleted user %d: %v", userID, err)
+	}
+
+	return scheduledAt, nil
+}
+
+// PurgeDeletedAccounts 유예 기간이 지난 탈퇴 사용자 영구 삭제 (백그라운드 작업)
+func (s *Service) PurgeDeletedAccounts(ctx context.Context) (int, error) {
+	userIDs, err := s.userRepo.FindPurgeTargets(time.Now(), purgeBatchSize)
+	if err != nil {
+		return 0, err
+	}
+	return s.purgeUsers(ctx, userIDs)
+}
+
+// PurgeAbandonedGuests 정식 계정으로 전환되지 않고 방치된 게스트 계정 영구 삭제 (백그라운드 작업)
+func (s *Service) PurgeAbandonedGuests(ctx context.Context) (int, error) {
+	userIDs, err := s.userRepo.FindAbandonedGuests(time.Now().Add(-s.guestTTL), purgeBatchSize)
+	if err != nil {
+		return 0, err
+	}
+	return s.purgeUsers(ctx, userIDs)
+}
+
+// purgeUsers 사용자와 모든 데이터 영구 삭제
+func (s *Service) purgeUsers(ctx context.Context, userIDs []uint) (int, error) {
+	purged := 0
+	for _, userID := range userIDs {
+		if err := ctx.Err(); err != nil {
+			return purged, err
+		}
+		if err := s.userRepo.PurgeUser(userID); err != nil {
+			log.Printf("Failed to purge user %d: %v", userID, err)
+			continue
+		}
+		purged++
+	}
+	return purged, nil
+}
+
+// ExportData 사용자의 모든 데이터 조회 (개인정보 열람/이동 요청)
+func (s *Service) ExportData(userID uint) (*DataExport, error) {
+	user, err := s.userRepo.FindByID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	export := &DataExport{
+		ExportedAt: time.Now(),
+		User:       user,
+	}
+
+	if export.Identities, err = s.userRepo.ListIdentities(userID); err != nil {
+		return nil, err
+	}
+	if export.Sessions, err = s.userRepo.ListSessions(userID); err != nil {
+		return nil, err
+	}
+	if export.DailySets, err = s.userRepo.ListDailySets(userID); err != nil {
+		return nil, err
+	}
+	if export.LearningProgress, err = s.userRepo.ListLearningProgress(userID); err != nil {
+		return nil, err
+	}
+	if export.Reviews, err = s.userRepo.ListReviews(userID); err != nil {
+		return nil, err
+	}
+	if export.QuizAttempts, err = s.userRepo.ListQuizAttempts(userID); err != nil {
+		return nil, err
+	}
+	if export.BookmarkFolders, err = s.userRepo.ListBookmarkFolders(userID); err != nil {
+		return nil, err
+	}
+	if export.Bookmarks, err = s.userRepo.ListBookmarks(userID); err != nil {
+		return nil, err
+	}
+	if export.ChatSessions, err = s.userRepo.ListChatSessions(userID); err != nil {
+		return nil, err
+	}
+	if export.Feedbacks, err = s.userRepo.ListFeedbacks(userID); err != nil {
+		return nil, err
+	}
+
+	return export, nil
+}
+
+// WriteZip 항목별 JSON 파일로 ZIP 아카이브 작성
+func (e *DataExport) WriteZip(w io.Writer) error {
+	files := []struct {
+		name string
+		data any
+	}{
+		{"user.json", e.User},
+		{"identities.json", e.Identities},
+		{"sessions.json", e.Sessions},
+		{"daily_sets.json", e.DailySets},
+		{"learning_progress.json", e.LearningProgress},
+		{"reviews.json", e.Reviews},
+		{"quiz_attempts.json", e.QuizAttempts},
+		{"bookmark_folders.json", e.BookmarkFolders},
+		{"bookmarks.json", e.Bookmarks},
+		{"chat_sessions.json", e.ChatSessions},
+		{"feedbacks.json", e.Feedbacks},
+	}
+
+	zw := zip.NewWriter(w)
+	for _, file := range files {
+		fw, err := zw.CreateHeader(&zip.FileHeader{
+			Name:     file.name,
+			Method:   zip.Deflate,
+			Modified: e.ExportedAt,
+		})
+		if err != nil {
+			return err
+		}
+
+		enc := json.NewEncoder(fw)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(file.data); err != nil {
+			return err
+		}
+	}
+	return zw.Close()
+}
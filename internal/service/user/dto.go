@@ -1,5 +1,11 @@
 package user
 
+import (
+	"time"
+
+	"github.com/jptaku/server/internal/model"
+)
+
 // UpdateProfileInput 프로필 업데이트 입력
 type UpdateProfileInput struct {
 	Name string `json:"name"`
@@ -7,9 +13,10 @@ type UpdateProfileInput struct {
 
 // OnboardingInput 온보딩 입력
 type OnboardingInput struct {
-	Level     int   `json:"level" binding:"min=0,max=5"`
-	Interests []int `json:"interests"` // pkg.SubCategory 값들
-	Purposes  []int `json:"purposes"`  // pkg.Purpose 값들
+	Level     int    `json:"level" binding:"min=0,max=3"`
+	Interests []int  `json:"interests"` // pkg.SubCategory 값들
+	Purposes  []int  `json:"purposes"`  // pkg.Purpose 값들
+	Timezone  string `json:"timezone"`  // IANA 타임존 (예: "Asia/Seoul"), 비우면 유지
 }
 
 // UpdateSettingsInput 설정 업데이트 입력
@@ -19,4 +26,21 @@ type UpdateSettingsInput struct {
 	PreferredVoiceSpeed *float64 `json:"preferred_voice_speed,omitempty"`
 	ShowRomaji          *bool    `json:"show_romaji,omitempty"`
 	ShowTranslation     *bool    `json:"show_translation,omitempty"`
+	Timezone            *string  `json:"timezone,omitempty"` // IANA 타임존 (예: "Asia/Seoul")
+}
+
+// DataExport 개인정보 내보내기 결과 (사용자의 모든 데이터)
+type DataExport struct {
+	ExportedAt       time.Time                `json:"exported_at"`
+	User             *model.User              `json:"user"`
+	Identities       []model.UserIdentity     `json:"identities"`
+	Sessions         []model.UserSession      `json:"sessions"`
+	DailySets        []model.DailySentenceSet `json:"daily_sets"`
+	LearningProgress []model.LearningProgress `json:"learning_progress"`
+	Reviews          []model.SentenceReview   `json:"reviews"`
+	QuizAttempts     []model.QuizAttempt      `json:"quiz_attempts"`
+	BookmarkFolders  []model.BookmarkFolder   `json:"bookmark_folders"`
+	Bookmarks        []model.SentenceBookmark `json:"bookmarks"`
+	ChatSessions     []model.ChatSession      `json:"chat_sessions"`
+	Feedbacks        []model.Feedback         `json:"feedbacks"`
 }
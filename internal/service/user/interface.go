@@ -1,6 +1,11 @@
 package user
 
-import "github.com/jptaku/server/internal/model"
+import (
+	"context"
+	"time"
+
+	"github.com/jptaku/server/internal/model"
+)
 
 // UserRepository 사용자 저장소 인터페이스
 type UserRepository interface {
@@ -12,6 +17,25 @@ type UserRepository interface {
 	GetSettings(userID uint) (*model.UserSettings, error)
 	CreateSettings(settings *model.UserSettings) error
 	UpdateSettings(settings *model.UserSettings) error
+	ListIdentities(userID uint) ([]model.UserIdentity, error)
+	ScheduleDeletion(userID uint, at time.Time) error
+	FindPurgeTargets(now time.Time, limit int) ([]uint, error)
+	PurgeUser(userID uint) error
+	FindAbandonedGuests(before time.Time, limit int) ([]uint, error)
+	ListSessions(userID uint) ([]model.UserSession, error)
+	ListDailySets(userID uint) ([]model.DailySentenceSet, error)
+	ListLearningProgress(userID uint) ([]model.LearningProgress, error)
+	ListReviews(userID uint) ([]model.SentenceReview, error)
+	ListQuizAttempts(userID uint) ([]model.QuizAttempt, error)
+	ListBookmarkFolders(userID uint) ([]model.BookmarkFolder, error)
+	ListBookmarks(userID uint) ([]model.SentenceBookmark, error)
+	ListChatSessions(userID uint) ([]model.ChatSession, error)
+	ListFeedbacks(userID uint) ([]model.Feedback, error)
+}
+
+// SessionRevoker 기기 세션 폐기 인터페이스 (auth.Service)
+type SessionRevoker interface {
+	RevokeAllSessions(ctx context.Context, userID uint) error
 }
 
 // SentenceProvider 문장 서비스 인터페이스
@@ -26,4 +50,8 @@ type Provider interface {
 	SaveOnboarding(userID uint, input *OnboardingInput) (*model.UserOnboarding, error)
 	GetSettings(userID uint) (*model.UserSettings, error)
 	UpdateSettings(userID uint, input *UpdateSettingsInput) (*model.UserSettings, error)
+	DeleteAccount(ctx context.Context, userID uint) (time.Time, error)
+	ExportData(userID uint) (*DataExport, error)
+	PurgeDeletedAccounts(ctx context.Context) (int, error)
+	PurgeAbandonedGuests(ctx context.Context) (int, error)
 }
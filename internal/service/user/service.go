@@ -1,21 +1,33 @@
 package user
 
-import "github.com/jptaku/server/internal/model"
+import (
+	"time"
+
+	"github.com/jptaku/server/internal/model"
+	"github.com/jptaku/server/internal/pkg"
+)
 
 // Service 사용자 서비스
 type Service struct {
 	userRepo        UserRepository
 	sentenceService SentenceProvider
+	sessions        SessionRevoker
+	deletionGrace   time.Duration
+	guestTTL        time.Duration
 }
 
 // 컴파일 타임 인터페이스 검증
 var _ Provider = (*Service)(nil)
 
 // NewService 서비스 생성자
-func NewService(userRepo UserRepository, sentenceService SentenceProvider) *Service {
+// deletionGrace는 탈퇴 요청 후 영구 삭제까지의 유예 기간, guestTTL은 사용 기록이 없는 게스트 계정을 정리하기까지의 기간입니다.
+func NewService(userRepo UserRepository, sentenceService SentenceProvider, sessions SessionRevoker, deletionGrace, guestTTL time.Duration) *Service {
 	return &Service{
 		userRepo:        userRepo,
 		sentenceService: sentenceService,
+		sessions:        sessions,
+		deletionGrace:   deletionGrace,
+		guestTTL:        guestTTL,
 	}
 }
 
@@ -43,7 +55,18 @@ func (s *Service) UpdateProfile(userID uint, input *UpdateProfileInput) (*model.
 }
 
 // SaveOnboarding 온보딩 저장
+// 타임존을 함께 보내면 사용자 설정에 저장합니다.
 func (s *Service) SaveOnboarding(userID uint, input *OnboardingInput) (*model.UserOnboarding, error) {
+	if err := validateOnboarding(input); err != nil {
+		return nil, err
+	}
+
+	if input.Timezone != "" {
+		if _, err := s.UpdateSettings(userID, &UpdateSettingsInput{Timezone: &input.Timezone}); err != nil {
+			return nil, err
+		}
+	}
+
 	onboarding, err := s.userRepo.GetOnboarding(userID)
 	isNewOnboarding := err != nil
 
@@ -69,6 +92,24 @@ func (s *Service) SaveOnboarding(userID uint, input *OnboardingInput) (*model.Us
 	return onboarding, nil
 }
 
+// validateOnboarding 레벨/관심사/목적 코드가 분류 체계(/api/meta)에 있는지 확인
+func validateOnboarding(input *OnboardingInput) error {
+	if !pkg.Level(input.Level).IsValid() {
+		return pkg.ErrUnknownTaxonomyCode
+	}
+	for _, interest := range input.Interests {
+		if !pkg.SubCategory(interest).IsValid() {
+			return pkg.ErrUnknownTaxonomyCode
+		}
+	}
+	for _, purpose := range input.Purposes {
+		if !pkg.Purpose(purpose).IsValid() {
+			return pkg.ErrUnknownTaxonomyCode
+		}
+	}
+	return nil
+}
+
 // GetSettings 설정 조회
 func (s *Service) GetSettings(userID uint) (*model.UserSettings, error) {
 	return s.userRepo.GetSettings(userID)
@@ -76,6 +117,10 @@ func (s *Service) GetSettings(userID uint) (*model.UserSettings, error) {
 
 // UpdateSettings 설정 업데이트
 func (s *Service) UpdateSettings(userID uint, input *UpdateSettingsInput) (*model.UserSettings, error) {
+	if input.Timezone != nil && !pkg.IsValidTimezone(*input.Timezone) {
+		return nil, pkg.ErrBadRequest
+	}
+
 	settings, err := s.userRepo.GetSettings(userID)
 	if err != nil {
 		settings = &model.UserSettings{UserID: userID}
@@ -99,6 +144,9 @@ func (s *Service) UpdateSettings(userID uint, input *UpdateSettingsInput) (*mode
 	if input.ShowTranslation != nil {
 		settings.ShowTranslation = *input.ShowTranslation
 	}
+	if input.Timezone != nil {
+		settings.Timezone = *input.Timezone
+	}
 
 	if err := s.userRepo.UpdateSettings(settings); err != nil {
 		return nil, err